@@ -0,0 +1,248 @@
+package datemath
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/DanStough/epok/natural"
+	"github.com/DanStough/epok/parse"
+)
+
+var ErrInvalidExpression = errors.New("invalid date-math expression")
+
+// Options control how expressions are evaluated by Eval.
+type Options struct {
+	// Now is the value of the "now" anchor. The default is the current time.
+	Now time.Time
+
+	// Location is the time zone for calendar math and rounding. The default is UTC.
+	Location *time.Location
+
+	// Parse controls how epoch anchors are read. When the anchor is "now" or a date,
+	// Parse.Unit is the precision of the result, which defaults to seconds.
+	Parse parse.Options
+}
+
+// operation is a single step of an expression, like "+2d" or "/h".
+type operation struct {
+	op   byte // op is one of '+', '-' or '/'.
+	n    int
+	unit natural.Unit
+}
+
+// units are the unit suffixes, ordered so that the longest match is tried first.
+var units = []struct {
+	suffix string
+	unit   natural.Unit
+}{
+	{"ms", natural.Millisecond},
+	{"us", natural.Microsecond},
+	{"µs", natural.Microsecond},
+	{"ns", natural.Nanosecond},
+	{"y", natural.Year},
+	{"Q", natural.Quarter},
+	{"M", natural.Month},
+	{"w", natural.Week},
+	{"d", natural.Day},
+	{"h", natural.Hour},
+	{"H", natural.Hour},
+	{"m", natural.Minute},
+	{"s", natural.Second},
+}
+
+// operationSyntax matches operations after an epoch anchor, like "+2d/d", without checking their units, so
+// dates like "2025-01-15" aren't mistaken for an epoch with operations.
+var operationSyntax = regexp.MustCompile(`^(?:[+-]\d*[^\d+\-/]+|/[^\d+\-/]+)+$`)
+
+// IsExpression reports whether the input is a date-math expression, rather than a plain epoch.
+// It's decided from the syntax alone: an expression has a date anchor followed by "||", starts with
+// "now", or has an epoch anchor followed by operations. Eval reports invalid operations, like unknown units.
+func IsExpression(s string) bool {
+	anchor, ops, _ := split(s)
+	switch {
+	case strings.Contains(s, "||"):
+		return true
+	case strings.EqualFold(anchor, "now"):
+		return ops == "" || strings.ContainsRune("+-/", rune(ops[0]))
+	default:
+		return isEpoch(anchor) && operationSyntax.MatchString(ops)
+	}
+}
+
+// isEpoch reports whether an anchor looks like an epoch, which is a single word with digits, like
+// "1751074598", "ms:-5000" or the NTP timestamp "ec09c5a6.80000000".
+func isEpoch(anchor string) bool {
+	return !strings.ContainsAny(anchor, " \t") && strings.ContainsAny(anchor, "0123456789")
+}
+
+// Eval evaluates a date-math expression. The result describes the anchor's precision, so it
+// can be printed at the same precision as the input, and every alternate reading of the anchor
+// has the same operations applied.
+func Eval(s string, opts Options) (parse.Result, error) {
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	anchor, ops, err := split(s)
+	if err != nil {
+		return parse.Result{}, err
+	}
+	operations, err := parseOperations(ops)
+	if err != nil {
+		return parse.Result{}, err
+	}
+
+	result, err := evalAnchor(s, anchor, now, loc, opts.Parse)
+	if err != nil {
+		return parse.Result{}, err
+	}
+
+	result.Time = apply(result.Time.In(loc), operations)
+	for i, alt := range result.Alternates {
+		result.Alternates[i].Time = apply(alt.Time.In(loc), operations)
+	}
+	// Rounding clears everything below the unit, including the digits finer than a nanosecond.
+	for _, o := range operations {
+		if o.op == '/' {
+			result.Subnanoseconds = ""
+		}
+	}
+	return result, nil
+}
+
+func evalAnchor(s, anchor string, now time.Time, loc *time.Location, opts parse.Options) (parse.Result, error) {
+	unit, epoch := opts.Unit, opts.Epoch.Name
+	if epoch == "" {
+		epoch = parse.EpochUnix
+	}
+	if epoch == parse.EpochUnix && unit == parse.Auto {
+		unit = parse.Seconds
+	}
+
+	switch {
+	case strings.EqualFold(anchor, "now"):
+		return parse.Result{Time: now, Epoch: epoch, Unit: unit, Confidence: parse.ConfidenceExact}, nil
+	case strings.Contains(s, "||"):
+		t, err := parse.Date(anchor, parse.DateOptions{Location: loc, Now: now})
+		if err != nil {
+			return parse.Result{}, err
+		}
+		return parse.Result{Time: t, Epoch: epoch, Unit: unit, Confidence: parse.ConfidenceExact}, nil
+	default:
+		return parse.Analyze(anchor, opts)
+	}
+}
+
+func apply(t time.Time, operations []operation) time.Time {
+	for _, o := range operations {
+		switch o.op {
+		case '+':
+			t = natural.Add(t, o.n, o.unit)
+		case '-':
+			t = natural.Add(t, -o.n, o.unit)
+		case '/':
+			t = natural.StartOf(t, o.unit)
+		}
+	}
+	return t
+}
+
+// split separates the anchor from the operations. The anchor is either "now", a date followed by "||",
+// or an epoch that parse can read.
+func split(s string) (string, string, error) {
+	s = strings.TrimSpace(s)
+
+	if anchor, ops, found := strings.Cut(s, "||"); found {
+		return strings.TrimSpace(anchor), removeSpaces(ops), nil
+	}
+	if len(s) >= 3 && strings.EqualFold(s[:3], "now") {
+		return s[:3], removeSpaces(s[3:]), nil
+	}
+
+	// Skip a precision prefix and the sign of the epoch, and don't confuse an exponent's sign
+	// for an operation. Hex digits can end in an e, but never have an exponent.
+	start := strings.Index(s, ":") + 1
+	if start < len(s) && (s[start] == '-' || s[start] == '+') {
+		start++
+	}
+	hex := strings.HasPrefix(strings.ToLower(s[start:]), "0x")
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '+', '-':
+			if !hex && i > 0 && (s[i-1] == 'e' || s[i-1] == 'E') {
+				continue
+			}
+			return strings.TrimSpace(s[:i]), removeSpaces(s[i:]), nil
+		case '/':
+			return strings.TrimSpace(s[:i]), removeSpaces(s[i:]), nil
+		}
+	}
+	return s, "", nil
+}
+
+// parseOperations reads operations like "+2d/d".
+func parseOperations(s string) ([]operation, error) {
+	var operations []operation
+	for s != "" {
+		o := operation{op: s[0]}
+		if o.op != '+' && o.op != '-' && o.op != '/' {
+			return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidExpression, s)
+		}
+		s = s[1:]
+
+		if o.op != '/' {
+			end := 0
+			for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+				end++
+			}
+			if end == 0 {
+				// Elasticsearch allows the amount to be omitted, e.g. "now+d".
+				o.n = 1
+			} else {
+				n, err := strconv.Atoi(s[:end])
+				if err != nil {
+					return nil, fmt.Errorf("%w: invalid amount %q", ErrInvalidExpression, s[:end])
+				}
+				o.n = n
+				s = s[end:]
+			}
+		}
+
+		matched := false
+		for _, u := range units {
+			if strings.HasPrefix(s, u.suffix) {
+				o.unit = u.unit
+				s = s[len(u.suffix):]
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			unit := s
+			if end := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) }); end >= 0 {
+				unit = s[:end]
+			}
+			if unit == "" {
+				return nil, fmt.Errorf("%w: missing unit in %q", ErrInvalidExpression, s)
+			}
+			return nil, fmt.Errorf("%w: unknown unit %q", ErrInvalidExpression, unit)
+		}
+
+		operations = append(operations, o)
+	}
+	return operations, nil
+}
+
+func removeSpaces(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
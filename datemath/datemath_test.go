@@ -0,0 +1,223 @@
+package datemath
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DanStough/epok/parse"
+)
+
+func TestEval(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2025, time.June, 28, 1, 36, 38, 123_000_000, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		loc      *time.Location
+		opts     parse.Options
+		expected time.Time
+		unit     parse.Unit
+		err      error
+	}{
+		{
+			name:     "now",
+			input:    "now",
+			expected: now,
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "now minus an hour",
+			input:    "now-1h",
+			expected: now.Add(-time.Hour),
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "now rounded to the day",
+			input:    "now/d",
+			expected: time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC),
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "now rounded to the day in a zone",
+			input:    "now/d",
+			loc:      newYork,
+			expected: time.Date(2025, time.June, 27, 0, 0, 0, 0, newYork),
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "now with a forced precision",
+			input:    "now-1d",
+			opts:     parse.Options{Unit: parse.Milliseconds},
+			expected: now.AddDate(0, 0, -1),
+			unit:     parse.Milliseconds,
+		},
+		{
+			name:     "epoch plus days rounded to the day",
+			input:    "1751074598+2d/d",
+			expected: time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC),
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "hex epoch ending in e",
+			input:    "0x685F472E+1d",
+			expected: time.Date(2025, time.June, 29, 1, 36, 46, 0, time.UTC),
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "millisecond epoch keeps its precision",
+			input:    "1751074598123-30m",
+			expected: time.Date(2025, time.June, 28, 1, 6, 38, 123_000_000, time.UTC),
+			unit:     parse.Milliseconds,
+		},
+		{
+			name:     "months and minutes are case-sensitive",
+			input:    "1751074598+1M+1m",
+			expected: time.Date(2025, time.July, 28, 1, 37, 38, 0, time.UTC),
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "omitted amount",
+			input:    "now+d",
+			expected: now.AddDate(0, 0, 1),
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "spaces between operations",
+			input:    "now - 1w / w",
+			expected: time.Date(2025, time.June, 16, 0, 0, 0, 0, time.UTC),
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "negative epoch",
+			input:    "-86400+1d",
+			expected: time.Unix(0, 0),
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "quarter rounding",
+			input:    "1751074598/Q",
+			expected: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+			unit:     parse.Seconds,
+		},
+		{
+			name:     "date anchor",
+			input:    "2025-01-31||+1M/M",
+			expected: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			unit:     parse.Seconds,
+		},
+		{
+			name:  "missing unit",
+			input: "now+1",
+			err:   ErrInvalidExpression,
+		},
+		{
+			name:  "unknown unit",
+			input: "now+1x",
+			err:   ErrInvalidExpression,
+		},
+		{
+			name:  "unknown unit after an epoch",
+			input: "1751074598+1fortnight",
+			err:   ErrInvalidExpression,
+		},
+		{
+			name:  "invalid anchor",
+			input: "banana+1d",
+			err:   parse.ErrInvalidFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := tt.loc
+			if loc == nil {
+				loc = time.UTC
+			}
+
+			result, err := Eval(tt.input, Options{Now: now, Location: loc, Parse: tt.opts})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if !result.Time.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result.Time)
+			}
+			if result.Unit != tt.unit {
+				t.Errorf("expected unit %v, got %v", tt.unit, result.Unit)
+			}
+		})
+	}
+}
+
+func TestEvalAlternates(t *testing.T) {
+	result, err := Eval("1751074598+1d", Options{Parse: parse.Options{Unit: parse.Milliseconds}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// As seconds, 1751074598 is June 28, 2025. The operation applies to it too.
+	expected := time.Date(2025, time.June, 29, 1, 36, 38, 0, time.UTC)
+	if len(result.Alternates) == 0 || !result.Alternates[0].Time.Equal(expected) {
+		t.Errorf("expected first alternate %v, got %v", expected, result.Alternates)
+	}
+}
+
+func TestEvalSubnanoseconds(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "1751074598123456789012+1h", expected: "012"},
+		{input: "1751074598123456789012/h", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := Eval(tt.input, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Subnanoseconds != tt.expected {
+				t.Errorf("expected sub-nanosecond digits %q, got %q", tt.expected, result.Subnanoseconds)
+			}
+		})
+	}
+}
+
+func TestIsExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "now", expected: true},
+		{input: "NOW-1h", expected: true},
+		{input: "1751074598+2d/d", expected: true},
+		{input: "2025-06-28||/d", expected: true},
+		{input: "1751074598", expected: false},
+		{input: "-1751074598", expected: false},
+		{input: "1.751074598e+9", expected: false},
+		{input: "ms:-5000", expected: false},
+		{input: "+1751074598", expected: false},
+		{input: "1,751,074,598", expected: false},
+		{input: "3 days ago", expected: false},
+		{input: "now+banana", expected: true},
+		{input: "1751074598+1fortnight", expected: true},
+		{input: "now in 3 days", expected: false},
+		{input: "2025-01-15 14:00", expected: false},
+		{input: "tomorrow 9am in America/New_York", expected: false},
+		{input: "-90min", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := IsExpression(tt.input); result != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Package datemath is a module for evaluating date-math expressions on epochs, like the syntax used by
+// Elasticsearch and Grafana. An expression is an anchor followed by any number of operations:
+//
+//	now-1h          one hour ago
+//	now/d           the start of today
+//	1751074598+2d/d the start of the day, two days after the epoch
+//	2025-06-28||+1M the same time, one month after the date
+//
+// Operations add (+) or subtract (-) an amount of a unit, or round down (/) to the start of a unit.
+// The units are y (years), Q (quarters), M (months), w (weeks), d (days), h or H (hours), m (minutes),
+// s (seconds), ms, us and ns. Calendar units follow the rules of the target time zone.
+package datemath
@@ -0,0 +1,7 @@
+// Package id is a module for extracting the timestamps embedded in time-ordered identifiers, like
+// Snowflakes, UUIDs, ULIDs, KSUIDs and MongoDB ObjectIDs.
+//
+// Decode detects the type of an identifier from its format. Snowflakes are plain integers whose layout
+// depends on the system that minted them, so the epoch and the position of the timestamp are
+// configurable with a SnowflakeLayout.
+package id
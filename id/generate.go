@@ -0,0 +1,196 @@
+package id
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fill selects the bits of a generated ID other than its timestamp.
+type Fill int
+
+const (
+	// FillRandom makes unique IDs.
+	FillRandom Fill = iota
+	// FillMin makes the smallest ID for the time, which is the lower bound of a range scan.
+	FillMin
+	// FillMax makes the largest ID for the time, which is the upper bound of a range scan.
+	FillMax
+)
+
+// GenerateOptions control how IDs are built by Generate.
+type GenerateOptions struct {
+	Fill Fill
+
+	// Snowflake is the layout of Snowflake IDs. The default is TwitterSnowflake.
+	Snowflake SnowflakeLayout
+
+	// Rand is the source of random bits. The default is crypto/rand.
+	Rand io.Reader
+}
+
+// Generate builds an ID whose embedded timestamp is t, truncated to the precision of the ID. Only the
+// versions of UUID with a Unix timestamp, UUIDv7, can be generated.
+func Generate(kind Kind, t time.Time, opts GenerateOptions) (string, error) {
+	switch kind {
+	case Snowflake:
+		layout := opts.Snowflake
+		if layout.Epoch.IsZero() {
+			layout = TwitterSnowflake
+		}
+		return layout.generate(t, opts)
+	case UUIDv7, uuid:
+		return generateUUIDv7(t, opts)
+	case ULID:
+		return generateULID(t, opts)
+	case KSUID:
+		return generateKSUID(t, opts)
+	case ObjectID:
+		return generateObjectID(t, opts)
+	default:
+		return "", fmt.Errorf("%w: %s can't be generated", ErrUnknownKind, kind)
+	}
+}
+
+// fill sets b to the bits chosen by the options.
+func fill(b []byte, opts GenerateOptions) error {
+	switch opts.Fill {
+	case FillMin:
+		clear(b)
+	case FillMax:
+		for i := range b {
+			b[i] = 0xff
+		}
+	default:
+		r := opts.Rand
+		if r == nil {
+			r = rand.Reader
+		}
+		if _, err := io.ReadFull(r, b); err != nil {
+			return fmt.Errorf("could not read random bits: %w", err)
+		}
+	}
+	return nil
+}
+
+// unixMilli48 returns the milliseconds of t, if they fit in the 48 bits used by UUIDv7 and ULID.
+func unixMilli48(t time.Time) (uint64, error) {
+	ms := t.UnixMilli()
+	if ms < 0 || ms >= 1<<48 {
+		return 0, fmt.Errorf("%w: must be between 1970 and 10889", ErrOutOfRange)
+	}
+	return uint64(ms), nil
+}
+
+func (l SnowflakeLayout) generate(t time.Time, opts GenerateOptions) (string, error) {
+	if l.Shift >= 64 {
+		return "", fmt.Errorf("%w: snowflake shift must be less than 64 bits", ErrUnknownFormat)
+	}
+	if t.Before(l.Epoch) {
+		return "", fmt.Errorf("%w: must be after the snowflake epoch %s", ErrOutOfRange, l.Epoch.UTC().Format(time.RFC3339))
+	}
+
+	// The sign bit is unused, so snowflakes fit in an int64. time.Time.Sub saturates at the maximum
+	// duration, which is out of range too.
+	elapsed := t.Sub(l.Epoch)
+	ticks := uint64(elapsed / l.Tick)
+	if elapsed == math.MaxInt64 || ticks >= 1<<(63-l.Shift) {
+		return "", fmt.Errorf("%w: too far past the snowflake epoch", ErrOutOfRange)
+	}
+
+	var low [8]byte
+	if err := fill(low[:], opts); err != nil {
+		return "", err
+	}
+	mask := uint64(1)<<l.Shift - 1
+	return strconv.FormatUint(ticks<<l.Shift|binary.BigEndian.Uint64(low[:])&mask, 10), nil
+}
+
+func generateUUIDv7(t time.Time, opts GenerateOptions) (string, error) {
+	ms, err := unixMilli48(t)
+	if err != nil {
+		return "", err
+	}
+
+	var b [16]byte
+	if err := fill(b[6:], opts); err != nil {
+		return "", err
+	}
+	b[0], b[1], b[2], b[3], b[4], b[5] = byte(ms>>40), byte(ms>>32), byte(ms>>24), byte(ms>>16), byte(ms>>8), byte(ms)
+	b[6] = 0x70 | b[6]&0x0f // version 7
+	b[8] = 0x80 | b[8]&0x3f // RFC 9562 variant
+
+	s := hex.EncodeToString(b[:])
+	return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:], nil
+}
+
+func generateULID(t time.Time, opts GenerateOptions) (string, error) {
+	ms, err := unixMilli48(t)
+	if err != nil {
+		return "", err
+	}
+
+	var entropy [10]byte
+	if err := fill(entropy[:], opts); err != nil {
+		return "", err
+	}
+
+	// 128 bits are encoded as 26 characters of 5 bits, with the 2 leading bits always zero.
+	n := new(big.Int).SetUint64(ms)
+	n.Lsh(n, 80).Or(n, new(big.Int).SetBytes(entropy[:]))
+
+	var sb strings.Builder
+	for i := 25; i >= 0; i-- {
+		digit := new(big.Int).Rsh(n, uint(5*i)).Uint64() & 0x1f
+		sb.WriteByte(crockford[digit])
+	}
+	return sb.String(), nil
+}
+
+func generateKSUID(t time.Time, opts GenerateOptions) (string, error) {
+	seconds := t.Unix() - ksuidEpoch
+	if seconds < 0 || seconds > 0xffffffff {
+		return "", fmt.Errorf("%w: must be between 2014 and 2150", ErrOutOfRange)
+	}
+
+	var b [20]byte
+	if err := fill(b[4:], opts); err != nil {
+		return "", err
+	}
+	binary.BigEndian.PutUint32(b[:4], uint32(seconds))
+
+	// KSUIDs are the 160-bit value in base62, padded with zeros to 27 characters.
+	n := new(big.Int).SetBytes(b[:])
+	base := big.NewInt(62)
+	digits := make([]byte, 27)
+	for i := range digits {
+		digits[i] = '0'
+	}
+	mod := new(big.Int)
+	for i := len(digits) - 1; n.Sign() > 0; i-- {
+		n.DivMod(n, base, mod)
+		digits[i] = base62[mod.Int64()]
+	}
+	return string(digits), nil
+}
+
+func generateObjectID(t time.Time, opts GenerateOptions) (string, error) {
+	seconds := t.Unix()
+	if seconds < 0 || seconds > 0xffffffff {
+		return "", fmt.Errorf("%w: must be between 1970 and 2106", ErrOutOfRange)
+	}
+
+	var b [12]byte
+	if err := fill(b[4:], opts); err != nil {
+		return "", err
+	}
+	binary.BigEndian.PutUint32(b[:4], uint32(seconds))
+	return hex.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,131 @@
+package id
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGenerate(t *testing.T) {
+	instant := time.Date(2025, time.June, 28, 1, 36, 38, 123_456_789, time.UTC)
+	midnight := time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		kind     Kind
+		at       time.Time
+		opts     GenerateOptions
+		expected string
+		err      error
+	}{
+		{
+			name:     "smallest ulid at midnight",
+			kind:     ULID,
+			at:       midnight,
+			opts:     GenerateOptions{Fill: FillMin},
+			expected: "01JYSXAX000000000000000000",
+		},
+		{
+			name:     "largest uuidv7",
+			kind:     UUIDv7,
+			at:       instant,
+			opts:     GenerateOptions{Fill: FillMax},
+			expected: "0197b42d-eceb-7fff-bfff-ffffffffffff",
+		},
+		{
+			name:     "random bits",
+			kind:     ObjectID,
+			at:       instant,
+			opts:     GenerateOptions{Rand: bytes.NewReader([]byte{1, 2, 3, 4, 5, 6, 7, 8})},
+			expected: "685f47260102030405060708",
+		},
+		{
+			name:     "smallest ksuid",
+			kind:     KSUID,
+			at:       instant,
+			opts:     GenerateOptions{Fill: FillMin},
+			expected: "2z7GFzji28XbdFbM0HfR7WmqJw8",
+		},
+		{
+			name:     "largest snowflake",
+			kind:     Snowflake,
+			at:       instant,
+			opts:     GenerateOptions{Fill: FillMax},
+			expected: "1938773501666131967",
+		},
+		{
+			name: "before the snowflake epoch",
+			kind: Snowflake,
+			at:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			err:  ErrOutOfRange,
+		},
+		{
+			name: "before the ksuid epoch",
+			kind: KSUID,
+			at:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			err:  ErrOutOfRange,
+		},
+		{
+			name: "uuidv1 can't be generated",
+			kind: UUIDv1,
+			at:   instant,
+			err:  ErrUnknownKind,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Generate(tt.kind, tt.at, tt.opts)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestGenerateRoundTrip(t *testing.T) {
+	instant := time.Date(2025, time.June, 28, 1, 36, 38, 123_456_789, time.UTC)
+
+	tests := []struct {
+		kind      Kind
+		precision time.Duration
+	}{
+		{kind: Snowflake, precision: time.Millisecond},
+		{kind: UUIDv7, precision: time.Millisecond},
+		{kind: ULID, precision: time.Millisecond},
+		{kind: KSUID, precision: time.Second},
+		{kind: ObjectID, precision: time.Second},
+	}
+
+	for _, tt := range tests {
+		for _, fill := range []Fill{FillRandom, FillMin, FillMax} {
+			t.Run(string(tt.kind), func(t *testing.T) {
+				generated := mustGenerate(t, tt.kind, instant, GenerateOptions{Fill: fill})
+
+				result, err := Decode(generated, Options{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if result.Kind != tt.kind {
+					t.Errorf("expected kind %v, got %v", tt.kind, result.Kind)
+				}
+				if expected := instant.Truncate(tt.precision); !result.Time.Equal(expected) {
+					t.Errorf("expected %v, got %v for %s", expected, result.Time, generated)
+				}
+			})
+		}
+	}
+}
+
+func mustGenerate(t *testing.T, kind Kind, at time.Time, opts GenerateOptions) string {
+	t.Helper()
+	generated, err := Generate(kind, at, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return generated
+}
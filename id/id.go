@@ -0,0 +1,310 @@
+package id
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unrecognized ID format")
+	ErrNoTimestamp   = errors.New("ID has no timestamp")
+	ErrUnknownKind   = errors.New("unknown ID type")
+	ErrOutOfRange    = errors.New("time can't be represented by the ID")
+)
+
+// Kind is a type of identifier.
+type Kind string
+
+const (
+	Auto      Kind = ""
+	Snowflake Kind = "snowflake"
+	UUIDv1    Kind = "uuidv1"
+	UUIDv6    Kind = "uuidv6"
+	UUIDv7    Kind = "uuidv7"
+	ULID      Kind = "ulid"
+	KSUID     Kind = "ksuid"
+	ObjectID  Kind = "objectid"
+)
+
+// Kinds are the supported types of identifiers.
+var Kinds = []Kind{Snowflake, UUIDv1, UUIDv6, UUIDv7, ULID, KSUID, ObjectID}
+
+// ParseKind reads a type of identifier. "uuid" matches any version, and "auto" or an empty string
+// detects the type.
+func ParseKind(s string) (Kind, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch s {
+	case "", "auto":
+		return Auto, nil
+	case "uuid":
+		return uuid, nil
+	case "mongo", "bson":
+		return ObjectID, nil
+	}
+	for _, k := range Kinds {
+		if string(k) == s {
+			return k, nil
+		}
+	}
+	return Auto, ErrUnknownKind
+}
+
+// uuid is any version of UUID, which is narrowed down by the version nibble.
+const uuid Kind = "uuid"
+
+// Options control how identifiers are read by Decode.
+type Options struct {
+	// Kind forces the type of the identifier instead of detecting it from the format.
+	Kind Kind
+
+	// Snowflake is the layout of Snowflake IDs. The default is TwitterSnowflake.
+	Snowflake SnowflakeLayout
+}
+
+// Result is the type of an identifier and the time it was minted.
+type Result struct {
+	Kind Kind
+	Time time.Time
+}
+
+// Decode extracts the timestamp from an identifier.
+func Decode(s string, opts Options) (Result, error) {
+	s = strings.TrimSpace(s)
+
+	kind := opts.Kind
+	if kind == Auto {
+		kind = detect(s)
+		if kind == Auto {
+			return Result{}, ErrUnknownFormat
+		}
+	}
+
+	var t time.Time
+	var err error
+	switch kind {
+	case Snowflake:
+		layout := opts.Snowflake
+		if layout.Epoch.IsZero() {
+			layout = TwitterSnowflake
+		}
+		t, err = layout.decode(s)
+	case uuid, UUIDv1, UUIDv6, UUIDv7:
+		var version Kind
+		version, t, err = decodeUUID(s)
+		if err == nil && kind != uuid && version != kind {
+			err = fmt.Errorf("%w: %s is a %s", ErrUnknownFormat, s, version)
+		}
+		kind = version
+	case ULID:
+		t, err = decodeULID(s)
+	case KSUID:
+		t, err = decodeKSUID(s)
+	case ObjectID:
+		t, err = decodeObjectID(s)
+	default:
+		err = ErrUnknownKind
+	}
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Kind: kind, Time: t}, nil
+}
+
+// detect guesses the type of an identifier from its length and alphabet.
+func detect(s string) Kind {
+	switch {
+	case isDigits(s) && len(s) <= 20:
+		return Snowflake
+	case len(s) == 36 || len(s) == 38 && s[0] == '{' || strings.HasPrefix(strings.ToLower(s), "urn:uuid:") || (len(s) == 32 && isHex(s)):
+		return uuid
+	case len(s) == 26:
+		return ULID
+	case len(s) == 27:
+		return KSUID
+	case len(s) == 24 && isHex(s):
+		return ObjectID
+	}
+	return Auto
+}
+
+// SnowflakeLayout describes where the timestamp is in a Snowflake ID.
+type SnowflakeLayout struct {
+	Name string
+	// Epoch is the origin of the timestamp.
+	Epoch time.Time
+	// Shift is the number of bits below the timestamp, like the worker and sequence numbers.
+	Shift uint
+	// Tick is the unit of the timestamp, usually a millisecond.
+	Tick time.Duration
+}
+
+var (
+	TwitterSnowflake = SnowflakeLayout{Name: "twitter", Epoch: time.UnixMilli(1288834974657), Shift: 22, Tick: time.Millisecond}
+	DiscordSnowflake = SnowflakeLayout{Name: "discord", Epoch: time.UnixMilli(1420070400000), Shift: 22, Tick: time.Millisecond}
+	// Sonyflake counts 10ms ticks, above 8 bits of sequence and 16 bits of machine ID.
+	Sonyflake = SnowflakeLayout{Name: "sonyflake", Epoch: time.Date(2014, time.September, 1, 0, 0, 0, 0, time.UTC), Shift: 24, Tick: 10 * time.Millisecond}
+)
+
+// SnowflakeLayouts are the well-known Snowflake layouts.
+var SnowflakeLayouts = []SnowflakeLayout{TwitterSnowflake, DiscordSnowflake, Sonyflake}
+
+// LookupSnowflakeLayout finds a well-known Snowflake layout by name.
+func LookupSnowflakeLayout(name string) (SnowflakeLayout, error) {
+	for _, l := range SnowflakeLayouts {
+		if strings.EqualFold(l.Name, name) {
+			return l, nil
+		}
+	}
+	return SnowflakeLayout{}, fmt.Errorf("%w: unknown snowflake layout %s", ErrUnknownKind, name)
+}
+
+func (l SnowflakeLayout) decode(s string) (time.Time, error) {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: snowflakes are 64-bit integers", ErrUnknownFormat)
+	}
+	if l.Shift >= 64 {
+		return time.Time{}, fmt.Errorf("%w: snowflake shift must be less than 64 bits", ErrUnknownFormat)
+	}
+	if l.Tick <= 0 {
+		return time.Time{}, fmt.Errorf("%w: snowflake tick must be positive", ErrUnknownFormat)
+	}
+
+	// Durations are int64 nanoseconds, so a fine tick with a small shift can't reach every snowflake.
+	ticks := n >> l.Shift
+	if ticks > uint64(math.MaxInt64/int64(l.Tick)) {
+		return time.Time{}, fmt.Errorf("%w: too far past the snowflake epoch", ErrOutOfRange)
+	}
+	return l.Epoch.Add(time.Duration(ticks) * l.Tick), nil
+}
+
+// uuidEpoch is the origin of the timestamps in UUID versions 1 and 6, the start of the Gregorian calendar.
+var uuidEpoch = time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC)
+
+func decodeUUID(s string) (Kind, time.Time, error) {
+	s = strings.TrimPrefix(strings.ToLower(s), "urn:uuid:")
+	s = strings.Trim(s, "{}")
+	if len(s) == 36 {
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return Auto, time.Time{}, fmt.Errorf("%w: invalid UUID", ErrUnknownFormat)
+		}
+		s = strings.ReplaceAll(s, "-", "")
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != 16 {
+		return Auto, time.Time{}, fmt.Errorf("%w: invalid UUID", ErrUnknownFormat)
+	}
+
+	version := b[6] >> 4
+	switch version {
+	case 1:
+		// time_low, time_mid and time_hi are stored from least to most significant.
+		ticks := uint64(b[6]&0x0f)<<56 | uint64(b[7])<<48 | uint64(b[4])<<40 | uint64(b[5])<<32 |
+			uint64(b[0])<<24 | uint64(b[1])<<16 | uint64(b[2])<<8 | uint64(b[3])
+		return UUIDv1, gregorian(ticks), nil
+	case 6:
+		// Version 6 is version 1 with the timestamp reordered from most to least significant.
+		ticks := uint64(b[0])<<52 | uint64(b[1])<<44 | uint64(b[2])<<36 | uint64(b[3])<<28 |
+			uint64(b[4])<<20 | uint64(b[5])<<12 | uint64(b[6]&0x0f)<<8 | uint64(b[7])
+		return UUIDv6, gregorian(ticks), nil
+	case 7:
+		ms := uint64(b[0])<<40 | uint64(b[1])<<32 | uint64(b[2])<<24 | uint64(b[3])<<16 | uint64(b[4])<<8 | uint64(b[5])
+		return UUIDv7, time.UnixMilli(int64(ms)), nil
+	default:
+		return Auto, time.Time{}, fmt.Errorf("%w: UUID version %d", ErrNoTimestamp, version)
+	}
+}
+
+// gregorian converts 100ns ticks since the Gregorian reform to a time. The 60-bit count is too large
+// for a time.Duration, so the seconds are added separately.
+func gregorian(ticks uint64) time.Time {
+	const ticksPerSecond = uint64(time.Second / 100)
+	seconds, remainder := ticks/ticksPerSecond, ticks%ticksPerSecond
+	return time.Unix(uuidEpoch.Unix()+int64(seconds), int64(remainder)*100)
+}
+
+// crockford is the base32 alphabet of ULIDs.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+func decodeULID(s string) (time.Time, error) {
+	s = strings.ToUpper(s)
+	if len(s) != 26 || s[0] > '7' {
+		return time.Time{}, fmt.Errorf("%w: invalid ULID", ErrUnknownFormat)
+	}
+
+	var ms uint64
+	for i, r := range s {
+		digit := strings.IndexRune(crockford, r)
+		if digit < 0 {
+			return time.Time{}, fmt.Errorf("%w: invalid ULID", ErrUnknownFormat)
+		}
+		// The first 10 characters are the 48-bit timestamp, and the rest are random.
+		if i < 10 {
+			ms = ms<<5 | uint64(digit)
+		}
+	}
+	return time.UnixMilli(int64(ms)), nil
+}
+
+const (
+	// base62 is the alphabet of KSUIDs.
+	base62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// ksuidEpoch is the origin of KSUID timestamps, in Unix seconds.
+	ksuidEpoch = 1_400_000_000
+	// ksuidPayloadBits is the size of the random payload below the 32-bit timestamp.
+	ksuidPayloadBits = 128
+)
+
+func decodeKSUID(s string) (time.Time, error) {
+	if len(s) != 27 {
+		return time.Time{}, fmt.Errorf("%w: invalid KSUID", ErrUnknownFormat)
+	}
+
+	n := new(big.Int)
+	base := big.NewInt(62)
+	for _, r := range s {
+		digit := strings.IndexRune(base62, r)
+		if digit < 0 {
+			return time.Time{}, fmt.Errorf("%w: invalid KSUID", ErrUnknownFormat)
+		}
+		n.Mul(n, base).Add(n, big.NewInt(int64(digit)))
+	}
+
+	seconds := n.Rsh(n, ksuidPayloadBits)
+	if !seconds.IsUint64() || seconds.Uint64() > 0xffffffff {
+		return time.Time{}, fmt.Errorf("%w: invalid KSUID", ErrUnknownFormat)
+	}
+	return time.Unix(ksuidEpoch+int64(seconds.Uint64()), 0), nil
+}
+
+func decodeObjectID(s string) (time.Time, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != 12 {
+		return time.Time{}, fmt.Errorf("%w: invalid ObjectID", ErrUnknownFormat)
+	}
+	seconds := uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+	return time.Unix(int64(seconds), 0), nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isHex(s string) bool {
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -0,0 +1,160 @@
+package id
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDecode(t *testing.T) {
+	// The RFC 9562 examples were all minted at the same instant.
+	rfc9562 := time.Date(2022, time.February, 22, 19, 22, 22, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		opts     Options
+		kind     Kind
+		expected time.Time
+		err      error
+	}{
+		{
+			name:     "twitter snowflake",
+			input:    "1541815603606036480",
+			kind:     Snowflake,
+			expected: time.Date(2022, time.June, 28, 16, 7, 40, 105_000_000, time.UTC),
+		},
+		{
+			name:     "discord snowflake",
+			input:    "175928847299117063",
+			opts:     Options{Snowflake: DiscordSnowflake},
+			kind:     Snowflake,
+			expected: time.Date(2016, time.April, 30, 11, 18, 25, 796_000_000, time.UTC),
+		},
+		{
+			name:  "custom snowflake layout",
+			input: "4194304000",
+			opts: Options{Snowflake: SnowflakeLayout{
+				Epoch: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Shift: 22,
+				Tick:  time.Second,
+			}},
+			kind:     Snowflake,
+			expected: time.Date(2020, time.January, 1, 0, 16, 40, 0, time.UTC),
+		},
+		{
+			name:  "snowflake too far past the epoch for the tick",
+			input: "18446744073709551615",
+			opts: Options{Snowflake: SnowflakeLayout{
+				Epoch: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Shift: 0,
+				Tick:  time.Millisecond,
+			}},
+			err: ErrOutOfRange,
+		},
+		{
+			name:     "uuid v1",
+			input:    "C232AB00-9414-11EC-B3C8-9F6BDECED846",
+			kind:     UUIDv1,
+			expected: rfc9562,
+		},
+		{
+			name:     "uuid v6",
+			input:    "1EC9414C-232A-6B00-B3C8-9F6BDECED846",
+			kind:     UUIDv6,
+			expected: rfc9562,
+		},
+		{
+			name:     "uuid v7",
+			input:    "017F22E2-79B0-7CC3-98C4-DC0C0C07398F",
+			kind:     UUIDv7,
+			expected: rfc9562,
+		},
+		{
+			name:     "uuid urn without hyphens",
+			input:    "urn:uuid:017f22e279b07cc398c4dc0c0c07398f",
+			kind:     UUIDv7,
+			expected: rfc9562,
+		},
+		{
+			name:     "ulid",
+			input:    "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			kind:     ULID,
+			expected: time.UnixMilli(1469922850259),
+		},
+		{
+			name:     "ksuid",
+			input:    "0ujtsYcgvSTl8PAuAdqWYSMnLOv",
+			kind:     KSUID,
+			expected: time.Date(2017, time.October, 10, 4, 0, 47, 0, time.UTC),
+		},
+		{
+			name:     "objectid",
+			input:    "507f1f77bcf86cd799439011",
+			kind:     ObjectID,
+			expected: time.Date(2012, time.October, 17, 21, 13, 27, 0, time.UTC),
+		},
+		{
+			name:  "uuid v4 has no timestamp",
+			input: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			err:   ErrNoTimestamp,
+		},
+		{
+			name:  "forced uuid version mismatch",
+			input: "017F22E2-79B0-7CC3-98C4-DC0C0C07398F",
+			opts:  Options{Kind: UUIDv1},
+			err:   ErrUnknownFormat,
+		},
+		{
+			name:  "ulid overflow",
+			input: "81ARZ3NDEKTSV4RRFFQ69G5FAV",
+			err:   ErrUnknownFormat,
+		},
+		{
+			name:  "unknown format",
+			input: "banana",
+			err:   ErrUnknownFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Decode(tt.input, tt.opts)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if result.Kind != tt.kind {
+				t.Errorf("expected kind %v, got %v", tt.kind, result.Kind)
+			}
+			if !result.Time.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result.Time)
+			}
+		})
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Kind
+		err      error
+	}{
+		{input: "auto", expected: Auto},
+		{input: "UUIDv7", expected: UUIDv7},
+		{input: "mongo", expected: ObjectID},
+		{input: "uuid", expected: uuid},
+		{input: "guid", err: ErrUnknownKind},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			kind, err := ParseKind(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if kind != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, kind)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/parse"
+	"github.com/DanStough/epok/tz"
+)
+
+// newAtCmd creates the at subcommand.
+func newAtCmd() *cobra.Command {
+	atCmd := &cobra.Command{
+		Use:   "at date-time",
+		Short: "create unix timestamp for a human readable date-time",
+		Long: `Use the at command to convert a human-readable date-time into a unix epoch timestamp.
+It accepts RFC 3339 and ISO 8601 (including week and ordinal dates), RFC 1123, RFC 2822, 
+HTTP dates, syslog timestamps, the output of Go's time.Time.String() and forms like "2006-01-02 15:04".
+It also understands natural-language expressions like "3 days ago", "next monday 09:00" or "end of quarter".
+The precision can be adjusted using that flag.`,
+		GroupID: groupIDEpochCommands,
+		Example: `# generate unix timestamp in seconds
+epok at "2025-06-28T01:36:38Z"
+
+# generate unix timestamp in milliseconds for an HTTP date
+epok at "Sat, 28 Jun 2025 01:36:38 GMT" -p ms
+
+# read dates without a zone in a specific timezone
+epok at "2025-06-28 09:00" -z Asia/Tokyo
+
+# use a natural-language expression
+epok at tomorrow noon in Asia/Tokyo`,
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAt(cmd, args)
+		},
+		SilenceUsage: true,
+	}
+
+	atCmd.Flags().StringP("precision", "p", "seconds", "precision for unix timestamp. "+precisionUnits)
+	atCmd.Flags().StringP("timezone", "z", "Local",
+		"timezone for date-times that don't include an offset. Use 'Local' for system time. "+timezoneSpecifiers)
+
+	return atCmd
+}
+
+func runAt(cmd *cobra.Command, args []string) error {
+	var input string
+	var err error
+	if len(args) == 0 {
+		input, err = readFromStdin(cmd)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Allow unquoted input with spaces, like `epok at 2025-06-28 09:00`.
+		input = strings.Join(args, " ")
+	}
+
+	prec, err := getPrecision()
+	if err != nil {
+		return err
+	}
+	if prec == parse.Auto {
+		return fmt.Errorf("invalid precision flag: %s", viper.GetString("precision"))
+	}
+
+	timezone := viper.GetString("timezone")
+	loc, err := tz.Resolve(timezone)
+	if err != nil {
+		return fmt.Errorf("invalid timezone %s: %w", timezone, err)
+	}
+
+	t, err := parseDateTime(input, loc)
+	if err != nil {
+		return err
+	}
+
+	out := &AtOutput{
+		Time:      t.In(time.UTC),
+		Tzdata:    tzdataVersion(),
+		precision: prec,
+	}
+
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(cmd, mode, out)
+}
+
+var _ json.Marshaler = (*AtOutput)(nil)
+
+// AtOutput is the data needed to render the result of the at command.
+// It will serialize the time to variable precision.
+type AtOutput struct {
+	Time   time.Time // Time is always UTC time, since it shows up across all JSON outputs.
+	Tzdata string
+
+	precision parse.Unit
+}
+
+func (o *AtOutput) MarshalJSON() ([]byte, error) {
+	ts, err := formatEpoch(o.Time, o.precision)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Epoch  string
+		Time   string
+		Tzdata string `json:",omitempty"`
+	}{
+		Epoch:  ts,
+		Time:   o.Time.Format(time.RFC3339Nano),
+		Tzdata: o.Tzdata,
+	})
+}
+
+func (o *AtOutput) writeSimple(w io.Writer) error {
+	ts, err := formatEpoch(o.Time, o.precision)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", ts)
+	return err
+}
+
+func (o *AtOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+
+	epoch, err := formatEpoch(o.Time, o.precision)
+	if err != nil {
+		return err
+	}
+
+	caser := cases.Title(language.English)
+	label := fmt.Sprintf("%s Epoch:", caser.String(string(o.precision)))
+	_, err = fmt.Fprintln(w, sheet.Keyword.Render(label), sheet.Text.Render(epoch))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, sheet.Keyword.Render("Time:"), sheet.TextSubdued.Render(o.Time.Format(time.RFC3339Nano)))
+	return err
+}
+
+func (o *AtOutput) writeJson(w io.Writer) error {
+	bytes, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("could not marshal at output JSON: %w", err)
+	}
+	bytes = append(bytes, '\n')
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("could not write at output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func Test_At(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "happy path - RFC 3339",
+			args: []string{
+				"at",
+				"2025-06-28T01:36:38Z",
+			},
+			expectedOutput: []string{
+				"1751074598\n",
+			},
+		},
+		{
+			name: "happy path - milliseconds",
+			args: []string{
+				"at",
+				"Sat, 28 Jun 2025 01:36:38 GMT",
+				"-p",
+				"ms",
+			},
+			expectedOutput: []string{
+				"1751074598000\n",
+			},
+		},
+		{
+			name: "happy path - nanoseconds beyond int64",
+			args: []string{
+				"at",
+				"2500-01-01T00:00:00Z",
+				"-p",
+				"ns",
+			},
+			expectedOutput: []string{
+				"16725225600000000000\n",
+			},
+		},
+		{
+			name: "happy path - unquoted input with timezone",
+			args: []string{
+				"at",
+				"2025-06-28",
+				"10:36:38",
+				"-z",
+				"Asia/Tokyo",
+			},
+			expectedOutput: []string{
+				"1751074598\n",
+			},
+		},
+		{
+			name: "happy path - stdin",
+			args: []string{
+				"at",
+			},
+			in: "2025-W26-6T01:36:38Z\n",
+			expectedOutput: []string{
+				"1751074598\n",
+			},
+		},
+		{
+			name: "happy path - json output",
+			args: []string{
+				"at",
+				"2025-06-28T01:36:38.123456789Z",
+				"-ojson",
+				"-pns",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			expectedOutput: []string{
+				"{\"Epoch\":\"1751074598123456789\",\"Time\":\"2025-06-28T01:36:38.123456789Z\",\"Tzdata\":\"" + goTzdata + "\"}\n",
+			},
+		},
+		{
+			name: "happy path - natural-language expression",
+			args: []string{
+				"at",
+				"tomorrow",
+				"noon",
+				"in",
+				"Asia/Tokyo",
+			},
+			expectedOutput: []string{
+				"946782000\n",
+			},
+		},
+		{
+			name: "happy path - city timezone",
+			args: []string{
+				"at",
+				"2025-06-28",
+				"10:36:38",
+				"-z",
+				"tokyo",
+			},
+			expectedOutput: []string{
+				"1751074598\n",
+			},
+		},
+		{
+			name: "happy path - natural-language expression in an offset",
+			args: []string{
+				"at",
+				"tomorrow",
+				"noon",
+				"in",
+				"+09:00",
+			},
+			expectedOutput: []string{
+				"946782000\n",
+			},
+		},
+		{
+			name: "ambiguous timezone",
+			args: []string{
+				"at",
+				"2025-06-28",
+				"-z",
+				"IST",
+			},
+			expectedError: "invalid timezone IST: ambiguous time zone IST, could be India Standard Time +05:30 (Asia/Kolkata), " +
+				"Irish Standard Time +01:00 (Europe/Dublin), Israel Standard Time +02:00 (Asia/Jerusalem)",
+		},
+		{
+			name: "invalid date",
+			args: []string{
+				"at",
+				"orange",
+			},
+			expectedError: "could not parse date or expression: no date format matches; invalid expression: unexpected \"orange\"",
+		},
+		{
+			name: "unknown zone abbreviation",
+			args: []string{
+				"at",
+				"Mon, 02 Jan 2006 15:04:05 XYZ",
+			},
+			expectedError: "could not parse date or expression: invalid timestamp format: unknown zone abbreviation XYZ; " +
+				"invalid expression: unexpected \"Jan\"",
+		},
+		{
+			name: "invalid timezone",
+			args: []string{
+				"at",
+				"2025-06-28",
+				"-z",
+				"Mars/Olympus_Mons",
+			},
+			expectedError: "invalid timezone Mars/Olympus_Mons: unknown time zone Mars/Olympus_Mons",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/natural"
+	"github.com/DanStough/epok/parse"
+	"github.com/DanStough/epok/tz"
+)
+
+// newBetweenCmd creates the between subcommand.
+func newBetweenCmd() *cobra.Command {
+	betweenCmd := &cobra.Command{
+		Use:   "between epoch epoch",
+		Short: "find the difference between two timestamps",
+		Long: `Use the between command to find the time from the first timestamp to the second. Both are parsed
+like the parse command, with the precision inferred from their magnitude, and either can be "now".
+
+The difference is reported as a total in every precision, and broken down into years, months, days
+and time in the timezone flag, so daylight saving transitions and leap days are counted correctly.`,
+		GroupID: groupIDEpochCommands,
+		Example: `# find the time between two timestamps
+epok between 1751074598 1767225600
+
+# mix precisions, and break the difference down in New York time
+epok between 1741449600000 1741536000 -z America/New_York
+
+# count the weekdays until a timestamp
+epok between now 1767225600 --business-days`,
+
+		Args: cobra.ExactArgs(2),
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBetween(cmd, args)
+		},
+		SilenceUsage: true,
+	}
+
+	betweenCmd.Flags().StringP("timezone", "z", "Local",
+		"timezone for the calendar breakdown and business days. Use 'Local' for system time. "+timezoneSpecifiers)
+	betweenCmd.Flags().Bool("business-days", false, "count the weekdays from the first date up to the second")
+
+	return betweenCmd
+}
+
+func runBetween(cmd *cobra.Command, args []string) error {
+	// Both sides read the same "now", so `between now now` is zero.
+	now := time.Now()
+	from, err := parseSide(args[0], now)
+	if err != nil {
+		return fmt.Errorf("could not parse first timestamp: %w", err)
+	}
+	to, err := parseSide(args[1], now)
+	if err != nil {
+		return fmt.Errorf("could not parse second timestamp: %w", err)
+	}
+
+	timezone := viper.GetString("timezone")
+	loc, err := tz.Resolve(timezone)
+	if err != nil {
+		return fmt.Errorf("invalid timezone %s: %w", timezone, err)
+	}
+
+	out := newBetweenOutput(from, to, loc)
+	if viper.GetBool("business_days") {
+		days := natural.BusinessDays(from, to, loc)
+		out.BusinessDays = &days
+	}
+
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(cmd, mode, out)
+}
+
+// parseSide reads one side of the difference, which is either "now" or a timestamp.
+func parseSide(s string, now time.Time) (time.Time, error) {
+	if s == "now" {
+		return now, nil
+	}
+	return parse.String(s)
+}
+
+// betweenOutput is the data needed to render the result of the between command.
+// The totals are strings, since they don't fit in a JSON number.
+type betweenOutput struct {
+	From time.Time // From is always UTC time, since it shows up across all JSON outputs.
+	To   time.Time // To is always UTC time.
+	Zone string    // Zone is the timezone of the calendar breakdown.
+
+	// The totals are truncated toward zero.
+	Seconds      string
+	Milliseconds string
+	Microseconds string
+	Nanoseconds  string
+
+	Calendar     natural.Span
+	BusinessDays *int   `json:",omitempty"`
+	Tzdata       string `json:",omitempty"`
+}
+
+func newBetweenOutput(from, to time.Time, loc *time.Location) *betweenOutput {
+	nanos := big.NewInt(to.Unix() - from.Unix())
+	nanos.Mul(nanos, big.NewInt(int64(time.Second)))
+	nanos.Add(nanos, big.NewInt(int64(to.Nanosecond()-from.Nanosecond())))
+
+	total := func(unit time.Duration) string {
+		return new(big.Int).Quo(nanos, big.NewInt(int64(unit))).String()
+	}
+
+	return &betweenOutput{
+		From:         from.In(time.UTC),
+		To:           to.In(time.UTC),
+		Zone:         loc.String(),
+		Seconds:      total(time.Second),
+		Milliseconds: total(time.Millisecond),
+		Microseconds: total(time.Microsecond),
+		Nanoseconds:  nanos.String(),
+		Calendar:     natural.Between(from, to, loc),
+		Tzdata:       tzdataVersion(),
+	}
+}
+
+func (o *betweenOutput) writeSimple(w io.Writer) error {
+	var errs error
+
+	tw := tabwriter.NewWriter(w, 0, 0, 4, ' ', tabwriter.TabIndent)
+	for _, row := range o.rows() {
+		_, err := fmt.Fprintf(tw, "%s\t%s\n", row[0], row[1])
+		errs = errors.Join(errs, err)
+	}
+	return errors.Join(errs, tw.Flush())
+}
+
+func (o *betweenOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+
+	var errs error
+	for _, row := range o.rows() {
+		_, err := fmt.Fprintln(w, sheet.Keyword.Render(row[0]), sheet.Text.Render(row[1]))
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
+
+// rows are the labels and values shared by the simple and pretty outputs.
+func (o *betweenOutput) rows() [][2]string {
+	rows := [][2]string{
+		{"From:", o.From.Format(time.RFC3339Nano)},
+		{"To:", o.To.Format(time.RFC3339Nano)},
+		{"Seconds:", o.Seconds},
+		{"Milliseconds:", o.Milliseconds},
+		{"Microseconds:", o.Microseconds},
+		{"Nanoseconds:", o.Nanoseconds},
+		{"Calendar:", fmt.Sprintf("%s (%s)", o.Calendar, o.Zone)},
+	}
+	if o.BusinessDays != nil {
+		rows = append(rows, [2]string{"Business days:", fmt.Sprintf("%d", *o.BusinessDays)})
+	}
+	return rows
+}
+
+func (o *betweenOutput) writeJson(w io.Writer) error {
+	bytes, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("could not marshal between output JSON: %w", err)
+	}
+	bytes = append(bytes, '\n')
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("could not write between output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func Test_Between(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "happy path - mixed precisions",
+			args: []string{
+				"between",
+				"-z",
+				"UTC",
+				"1751074598",
+				"1767225600000",
+			},
+			expectedOutput: []string{
+				`From:            2025-06-28T01:36:38Z
+To:              2026-01-01T00:00:00Z
+Seconds:         16151002
+Milliseconds:    16151002000
+Microseconds:    16151002000000
+Nanoseconds:     16151002000000000
+Calendar:        6 months, 3 days, 22 hours, 23 minutes, 22 seconds (UTC)
+`,
+			},
+		},
+		{
+			name: "happy path - daylight saving in a zone",
+			args: []string{
+				"between",
+				"-ojson",
+				"-z",
+				"America/New_York",
+				"1741449600",
+				"1741532400",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			expectedOutput: []string{
+				"{\"From\":\"2025-03-08T16:00:00Z\",\"To\":\"2025-03-09T15:00:00Z\",\"Zone\":\"America/New_York\",\"Seconds\":\"82800\",\"Milliseconds\":\"82800000\",\"Microseconds\":\"82800000000\",\"Nanoseconds\":\"82800000000000\",\"Calendar\":{\"Years\":0,\"Months\":0,\"Days\":1,\"Hours\":0,\"Minutes\":0,\"Seconds\":0,\"Nanoseconds\":0},\"Tzdata\":\"" + goTzdata + "\"}\n",
+			},
+		},
+		{
+			name: "happy path - business days before now",
+			args: []string{
+				"between",
+				"-z",
+				"UTC",
+				"now",
+				"946080000",
+				"--business-days",
+			},
+			expectedOutput: []string{
+				"Calendar:         -7 days (UTC)",
+				"Business days:    -5",
+			},
+		},
+		{
+			name: "invalid timestamp",
+			args: []string{
+				"between",
+				"now",
+				"banana",
+			},
+			expectedError: "could not parse second timestamp: invalid timestamp format",
+		},
+		{
+			name: "missing timestamp",
+			args: []string{
+				"between",
+				"now",
+			},
+			expectedError: "accepts 2 arg(s), received 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
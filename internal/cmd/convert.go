@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/DanStough/epok/internal/i18n"
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/parse"
+	"github.com/DanStough/epok/tz"
+)
+
+// newConvertCmd creates the convert subcommand.
+func newConvertCmd() *cobra.Command {
+	convertCmd := &cobra.Command{
+		Use:   "convert date-time",
+		Short: "convert a wall-clock time between timezones",
+		Long: `Use the convert command to find a wall-clock time in other timezones, and its unix epoch timestamp.
+It reads the same date-times as the at command, in the timezone of the from flag unless they include an
+offset.
+
+A wall-clock time the clocks move back past happens twice, and one they move forward past doesn't happen
+at all. Both readings are shown for those, with a warning.`,
+		GroupID: groupIDTimezoneCommands,
+		Example: `# convert a meeting in New York to Tokyo and Berlin
+epok convert "2025-06-28 09:00" --from America/New_York --to Asia/Tokyo,Europe/Berlin
+
+# convert a wall-clock time that happens twice
+epok convert "2025-11-02 01:30" --from America/New_York --to UTC
+
+# convert a natural-language expression from system time
+epok convert tomorrow 17:00 --to tokyo`,
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConvert(cmd, args)
+		},
+		SilenceUsage: true,
+	}
+
+	convertCmd.Flags().StringP("from", "f", "Local",
+		"timezone of the date-time. Use 'Local' for system time. "+timezoneSpecifiers)
+	convertCmd.Flags().StringSlice("to", []string{"UTC"},
+		"timezones to convert the date-time to. "+timezoneSpecifiers)
+	convertCmd.Flags().StringP("precision", "p", "seconds", "precision for unix timestamp. "+precisionUnits)
+
+	return convertCmd
+}
+
+func runConvert(cmd *cobra.Command, args []string) error {
+	var input string
+	var err error
+	if len(args) == 0 {
+		input, err = readFromStdin(cmd)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Allow unquoted input with spaces, like `epok convert 2025-06-28 09:00`.
+		input = strings.Join(args, " ")
+	}
+	input = strings.TrimSpace(input)
+
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+	lang, err := getLanguage()
+	if err != nil {
+		return err
+	}
+
+	prec, err := getPrecision()
+	if err != nil {
+		return err
+	}
+	if prec == parse.Auto {
+		return fmt.Errorf("invalid precision flag: %s", viper.GetString("precision"))
+	}
+
+	from := viper.GetString("from")
+	fromLoc, err := tz.Resolve(from)
+	if err != nil {
+		return fmt.Errorf("invalid from timezone %s: %w", from, err)
+	}
+	locales := map[string]localeZone{fromLoc.String(): {Location: fromLoc}}
+
+	to := viper.GetStringSlice("to")
+	if len(to) == 0 {
+		return errors.New("must specify at least one timezone to convert to")
+	}
+	for _, timezone := range to {
+		loc, err := tz.Resolve(timezone)
+		if err != nil {
+			return fmt.Errorf("invalid to timezone %s: %w", timezone, err)
+		}
+		locales[loc.String()] = localeZone{Location: loc}
+	}
+
+	readings, transition, err := readWallTime(input, fromLoc)
+	if err != nil {
+		return err
+	}
+
+	out := &convertOutput{Input: input, From: fromLoc.String(), Tzdata: tzdataVersion(), lang: lang}
+	for _, reading := range readings {
+		epoch, err := formatEpoch(reading.Time, prec)
+		if err != nil {
+			return err
+		}
+		conversion := Conversion{Epoch: epoch, Locales: newLocales(reading.Time, locales)}
+		if len(readings) > 1 {
+			conversion.Reading = fmt.Sprintf("%s %s", reading.Time.In(readingZone(reading)).Format("15:04"),
+				reading.Zone.Abbreviation)
+		}
+		out.Conversions = append(out.Conversions, conversion)
+	}
+	if len(readings) > 1 {
+		out.Warnings = []string{wallTimeWarning(readings, transition)}
+	}
+
+	return writeOutput(cmd, mode, out)
+}
+
+// readWallTime parses a date-time like the at command, and reads its wall-clock time in loc. A date-time
+// with an offset, or a relative expression like "in 2 hours", is an instant and only has one reading.
+func readWallTime(input string, loc *time.Location) ([]tz.Reading, tz.Transition, error) {
+	t, err := parseDateTime(input, loc)
+	if err != nil {
+		return nil, tz.Transition{}, err
+	}
+
+	// Parse again in fixed zones around the offset of loc, so the wall-clock time isn't moved by a
+	// transition. When the instant doesn't depend on the zone, the input wasn't a wall-clock time.
+	offset := tz.At(loc, t).Offset
+	wall, err := parseDateTime(input, time.FixedZone("", offset))
+	if err != nil {
+		return nil, tz.Transition{}, err
+	}
+	shifted, err := parseDateTime(input, time.FixedZone("", offset+3600))
+	if err != nil {
+		return nil, tz.Transition{}, err
+	}
+	if wall.Equal(shifted) {
+		return []tz.Reading{{Time: t, Zone: tz.At(t.Location(), t)}}, tz.Transition{}, nil
+	}
+
+	readings, transition, _ := tz.Wall(loc, wall)
+	return readings, transition, nil
+}
+
+// readingZone is the zone a reading of a wall-clock time was made in, so the wall-clock time can be written
+// even when it's skipped.
+func readingZone(reading tz.Reading) *time.Location {
+	return time.FixedZone(reading.Zone.Abbreviation, reading.Zone.Offset)
+}
+
+// wallTimeWarning explains why a wall-clock time has two readings.
+func wallTimeWarning(readings []tz.Reading, transition tz.Transition) string {
+	wall := readings[0].Time.In(readingZone(readings[0]))
+	zone := readings[0].Time.Location().String()
+	date, clock := wall.Format(time.DateOnly), wall.Format("15:04")
+
+	if transition.Shift() < 0 {
+		return fmt.Sprintf("%s happens twice in %s on %s, as %s and %s, so both are shown",
+			clock, zone, date, transition.Before.Abbreviation, transition.After.Abbreviation)
+	}
+	from, to, _ := wallTimes(transition)
+	return fmt.Sprintf("%s doesn't happen in %s on %s, the clocks skip from %s to %s, so it's shown in %s and %s",
+		clock, zone, date, from, to, transition.Before.Abbreviation, transition.After.Abbreviation)
+}
+
+// convertOutput is the data needed to render the result of the convert command.
+type convertOutput struct {
+	Input       string
+	From        string // From is the timezone of the wall-clock time.
+	Conversions []Conversion
+
+	// Warnings are about wall-clock times that happen twice, or not at all, so there's more than one
+	// conversion.
+	Warnings []string `json:",omitempty"`
+	Tzdata   string   `json:",omitempty"`
+
+	lang i18n.Language
+}
+
+// Conversion is one reading of the wall-clock time, in every locale.
+type Conversion struct {
+	Epoch string
+
+	// Reading is the wall-clock time and the zone it was read in, like "01:30 EDT", when it has more than
+	// one reading.
+	Reading string `json:",omitempty"`
+	Locales []Locale
+}
+
+func (o *convertOutput) writeSimple(w io.Writer) error {
+	var errs error
+
+	for i, conversion := range o.Conversions {
+		if i > 0 {
+			_, err := fmt.Fprintln(w)
+			errs = errors.Join(errs, err)
+		}
+		if conversion.Reading != "" {
+			_, err := fmt.Fprintf(w, "As %s:\n", conversion.Reading)
+			errs = errors.Join(errs, err)
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 0, 4, ' ', tabwriter.TabIndent)
+		err := writeLocaleRows(tw, conversion.Locales, o.lang)
+		errs = errors.Join(errs, err, tw.Flush())
+
+		_, err = fmt.Fprintf(w, "\nEpoch: %s\n", conversion.Epoch)
+		errs = errors.Join(errs, err)
+	}
+
+	for _, warning := range o.Warnings {
+		_, err := fmt.Fprintf(w, "Warning: %s\n", warning)
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
+
+func (o *convertOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+
+	var errs error
+	for _, conversion := range o.Conversions {
+		if conversion.Reading != "" {
+			_, err := fmt.Fprintln(w, sheet.Keyword.Render(fmt.Sprintf("As %s:", conversion.Reading)))
+			errs = errors.Join(errs, err)
+		}
+
+		_, err := lipgloss.Fprintln(w, localeTable(sheet, conversion.Locales, o.lang))
+		errs = errors.Join(errs, err)
+
+		_, err = fmt.Fprintln(w, sheet.Keyword.Render("Epoch:"), sheet.Text.Render(o.lang.Number(conversion.Epoch)))
+		errs = errors.Join(errs, err)
+	}
+
+	for _, warning := range o.Warnings {
+		_, err := fmt.Fprintln(w, sheet.Keyword.Render("Warning:"), sheet.Text.Render(warning))
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
+
+func (o *convertOutput) writeJson(w io.Writer) error {
+	return writeJsonLine(w, o, "convert")
+}
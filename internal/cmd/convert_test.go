@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"testing"
+)
+
+// Test_Convert covers basic command functionality and validation.
+func Test_Convert(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "happy path - wall time to other zones",
+			args: []string{
+				"convert",
+				"2025-06-28 09:00",
+				"--from",
+				"America/New_York",
+				"--to",
+				"Asia/Tokyo,Europe/Berlin",
+			},
+			expectedOutput: []string{
+				"America/New_York    Saturday, June 28, 2025    09:00:00    -04:00    EDT     yes\n",
+				"Asia/Tokyo          Saturday, June 28, 2025    22:00:00    +09:00    JST     no\n",
+				"Europe/Berlin       Saturday, June 28, 2025    15:00:00    +02:00    CEST    yes\n",
+				"\nEpoch: 1751115600\n",
+			},
+		},
+		{
+			name: "happy path - unquoted args and other zone specifiers",
+			args: []string{
+				"convert",
+				"2025-06-28",
+				"09:00",
+				"-f",
+				"ET",
+				"--to",
+				"tokyo",
+				"-pms",
+			},
+			expectedOutput: []string{
+				"Asia/Tokyo          Saturday, June 28, 2025    22:00:00    +09:00    JST     no\n",
+				"Epoch: 1751115600000\n",
+			},
+		},
+		{
+			name: "happy path - json",
+			args: []string{
+				"convert",
+				"-ojson",
+				"2025-06-28 09:00",
+				"--from",
+				"America/New_York",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			expectedOutput: []string{
+				"{\"Input\":\"2025-06-28 09:00\",\"From\":\"America/New_York\",\"Conversions\":[{\"Epoch\":\"1751115600\",\"Locales\":[{\"Name\":\"America/New_York\",\"Time\":\"2025-06-28T09:00:00-04:00\",\"Offset\":\"-04:00\",\"Abbreviation\":\"EDT\",\"DST\":true},{\"Name\":\"UTC\",\"Time\":\"2025-06-28T13:00:00Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}]}],\"Tzdata\":\"" + goTzdata + "\"}\n",
+			},
+		},
+		{
+			name: "happy path - wall time that happens twice",
+			args: []string{
+				"convert",
+				"2025-11-02 01:30",
+				"--from",
+				"America/New_York",
+			},
+			expectedOutput: []string{
+				"As 01:30 EDT:\n",
+				"UTC                 Sunday, November 2, 2025    05:30:00    +00:00    UTC     no\n\nEpoch: 1762061400\n",
+				"As 01:30 EST:\n",
+				"UTC                 Sunday, November 2, 2025    06:30:00    +00:00    UTC     no\n\nEpoch: 1762065000\n",
+				"Warning: 01:30 happens twice in America/New_York on 2025-11-02, as EDT and EST, so both are shown\n",
+			},
+		},
+		{
+			name: "happy path - wall time that's skipped",
+			args: []string{
+				"convert",
+				"-ojson",
+				"2025-03-09 02:30",
+				"--from",
+				"America/New_York",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			expectedOutput: []string{
+				"{\"Epoch\":\"1741501800\",\"Reading\":\"02:30 EDT\",\"Locales\":[{\"Name\":\"America/New_York\",\"Time\":\"2025-03-09T01:30:00-05:00\"",
+				"{\"Epoch\":\"1741505400\",\"Reading\":\"02:30 EST\",\"Locales\":[{\"Name\":\"America/New_York\",\"Time\":\"2025-03-09T03:30:00-04:00\"",
+				"\"Warnings\":[\"02:30 doesn't happen in America/New_York on 2025-03-09, the clocks skip from 02:00 to 03:00, so it's shown in EST and EDT\"],\"Tzdata\":\"" + goTzdata + "\"}\n",
+			},
+		},
+		{
+			name: "happy path - an offset isn't ambiguous",
+			args: []string{
+				"convert",
+				"-ojson",
+				"2025-11-02T01:30:00-05:00",
+				"--from",
+				"America/New_York",
+			},
+			expectedOutput: []string{
+				"\"Conversions\":[{\"Epoch\":\"1762065000\",\"Locales\"",
+			},
+		},
+		{
+			name: "happy path - natural-language expression",
+			args: []string{
+				"convert",
+				"tomorrow 17:00",
+				"--from",
+				"Asia/Tokyo",
+			},
+			expectedOutput: []string{
+				"Asia/Tokyo    Sunday, January 2, 2000    17:00:00    +09:00    JST     no\n",
+				"UTC           Sunday, January 2, 2000    08:00:00    +00:00    UTC     no\n",
+			},
+		},
+		{
+			name: "happy path - stdin",
+			args: []string{
+				"convert",
+				"--from",
+				"UTC",
+				"--to",
+				"Asia/Kolkata",
+			},
+			in: "2025-06-28 09:00\n",
+			expectedOutput: []string{
+				"Asia/Kolkata    Saturday, June 28, 2025    14:30:00    +05:30    IST     no\n",
+			},
+		},
+		{
+			name: "invalid date",
+			args: []string{
+				"convert",
+				"the day after never",
+			},
+			expectedError: "could not parse date or expression: no date format matches; invalid expression: unexpected \"day\"",
+		},
+		{
+			name: "invalid from timezone",
+			args: []string{
+				"convert",
+				"2025-06-28 09:00",
+				"--from",
+				"Mars/Olympus_Mons",
+			},
+			expectedError: "invalid from timezone Mars/Olympus_Mons: unknown time zone Mars/Olympus_Mons",
+		},
+		{
+			name: "invalid to timezone",
+			args: []string{
+				"convert",
+				"2025-06-28 09:00",
+				"--to",
+				"UTC,Mars/Olympus_Mons",
+			},
+			expectedError: "invalid to timezone Mars/Olympus_Mons: unknown time zone Mars/Olympus_Mons",
+		},
+		{
+			name: "invalid precision",
+			args: []string{
+				"convert",
+				"2025-06-28 09:00",
+				"-p",
+				"auto",
+			},
+			expectedError: "invalid precision flag: auto",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
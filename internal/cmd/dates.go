@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DanStough/epok/natural"
+	"github.com/DanStough/epok/parse"
+	"github.com/DanStough/epok/tz"
+)
+
+// parseDateTime reads a human-readable date-time, falling back to natural-language expressions like
+// "tomorrow noon". When neither reads the input, the error gives both reasons.
+func parseDateTime(input string, loc *time.Location) (time.Time, error) {
+	t, err := parse.Date(input, parse.DateOptions{Location: loc})
+	if err == nil {
+		return t, nil
+	}
+	t, exprErr := natural.Parse(input, natural.Options{Location: loc, LoadZone: tz.Resolve})
+	if exprErr != nil {
+		reason := err.Error()
+		// The bare error is about timestamps, rather than why a date didn't match.
+		if err == parse.ErrInvalidFormat {
+			reason = "no date format matches"
+		}
+		return time.Time{}, fmt.Errorf("could not parse date or expression: %s; %w", reason, exprErr)
+	}
+	return t, nil
+}
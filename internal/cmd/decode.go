@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/DanStough/epok/internal/i18n"
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/natural"
+	"github.com/DanStough/epok/wire"
+)
+
+// newDecodeCmd creates the decode subcommand.
+func newDecodeCmd() *cobra.Command {
+	formats := make([]string, 0, len(wire.Formats))
+	for _, f := range wire.Formats {
+		formats = append(formats, string(f))
+	}
+
+	decodeCmd := &cobra.Command{
+		Use:   "decode data",
+		Short: "read a timestamp from a binary serialization format",
+		Long: `Use the decode command to read a timestamp from a wire capture, given as hex or base64.
+Valid formats are ` + strings.Join(formats, ", ") + `.
+
+A protobuf timestamp can also be given in its JSON form, {"seconds": ..., "nanos": ...}.`,
+		GroupID: groupIDEpochCommands,
+		Example: `# decode a serialized google.protobuf.Timestamp
+epok decode --format protobuf-timestamp 08a68efdc20610c0a9d33a
+
+# decode a BSON datetime from base64
+epok decode --format bson-datetime 6+wttJcBAAA=
+
+# decode a CBOR date-time piped from xxd
+xxd -p capture.bin | epok decode --format cbor-tag1`,
+
+		Args: cobra.MaximumNArgs(1),
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDecode(cmd, args)
+		},
+		SilenceUsage: true,
+	}
+
+	addLocalesFlag(decodeCmd)
+	addRelativeFlags(decodeCmd)
+	decodeCmd.Flags().StringP("format", "f", "",
+		"binary format of the timestamp. Valid formats are "+strings.Join(formats, ", "))
+	_ = decodeCmd.MarkFlagRequired("format")
+	return decodeCmd
+}
+
+func runDecode(cmd *cobra.Command, args []string) error {
+	var input string
+	var err error
+	if len(args) == 0 {
+		input, err = readFromStdin(cmd)
+		if err != nil {
+			return err
+		}
+	} else {
+		input = args[0]
+	}
+
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+
+	locales, err := getLocales(cmd)
+	if err != nil {
+		return err
+	}
+
+	lang, err := getLanguage()
+	if err != nil {
+		return err
+	}
+
+	relative, err := getRelativeOptions()
+	if err != nil {
+		return err
+	}
+
+	format, err := wire.ParseFormat(viper.GetString("format"))
+	if err != nil {
+		return fmt.Errorf("invalid format flag: %s", viper.GetString("format"))
+	}
+
+	t, err := wire.DecodeString(input, format)
+	if err != nil {
+		return fmt.Errorf("could not decode timestamp: %w", err)
+	}
+
+	out := newDecodeOutput(strings.TrimSpace(input), format, t, locales, relative, lang)
+
+	return writeOutput(cmd, mode, out)
+}
+
+type decodeOutput struct {
+	Data    string
+	Format  wire.Format
+	Locales []Locale
+
+	// Derived
+	Now      time.Time // Now is always UTC time, since it shows up across all JSON outputs.
+	Relative Relative
+	Tzdata   string `json:",omitempty"`
+
+	lang i18n.Language
+}
+
+func newDecodeOutput(input string, format wire.Format, t time.Time, localesByTz map[string]localeZone, relative natural.HumanizeOptions, lang i18n.Language) *decodeOutput {
+	now := time.Now().In(time.UTC)
+	return &decodeOutput{
+		Data:     input,
+		Format:   format,
+		Locales:  newLocales(t, localesByTz),
+		Now:      now,
+		Relative: newRelative(t, now, relative, lang),
+		Tzdata:   tzdataVersion(),
+
+		lang: lang,
+	}
+}
+
+func (o *decodeOutput) writeSimple(w io.Writer) error {
+	var errs error
+
+	tw := tabwriter.NewWriter(w, 0, 0, 4, ' ', tabwriter.TabIndent)
+	err := writeLocaleRows(tw, o.Locales, o.lang)
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintln(tw)
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintf(tw, "Format: %s\n", o.Format)
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintf(tw, "Relative: %s\n", o.Relative.Humanized)
+	errs = errors.Join(errs, err)
+
+	return errors.Join(errs, tw.Flush())
+}
+
+func (o *decodeOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+
+	var errs error
+	_, err := lipgloss.Fprintln(w, localeTable(sheet, o.Locales, o.lang))
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintln(w, sheet.Keyword.Render("Format:"), sheet.Text.Render(string(o.Format)))
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintln(w, sheet.Keyword.Render("Relative:"), o.Relative.render(sheet))
+	errs = errors.Join(errs, err)
+
+	return errs
+}
+
+func (o *decodeOutput) writeJson(w io.Writer) error {
+	bytes, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("could not marshal decode output JSON: %w", err)
+	}
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("could not write decode output: %w", err)
+	}
+	return nil
+}
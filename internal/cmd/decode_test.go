@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func Test_Decode(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "happy path - protobuf timestamp",
+			args: []string{
+				"decode",
+				"-z",
+				"UTC=UTC",
+				"--format",
+				"protobuf-timestamp",
+				"08a68efdc20610c0a9d33a",
+			},
+			expectedOutput: []string{
+				`LOCALE    DATE                       TIME            OFFSET    ZONE    DST
+UTC       Saturday, June 28, 2025    01:36:38.123    +00:00    UTC     no
+
+Format: protobuf-timestamp
+Relative: 25 years, 5 months from now`,
+			},
+		},
+		{
+			name: "happy path - base64 from stdin",
+			args: []string{
+				"decode",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"-f",
+				"bson",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			in: "6+wttJcBAAA=\n",
+			expectedOutput: []string{
+				"{\"Data\":\"6+wttJcBAAA=\",\"Format\":\"bson-datetime\",\"Locales\":[{\"Name\":\"UTC\",\"Time\":\"2025-06-28T01:36:38.123Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}],\"Now\":\"2000-01-01T00:00:00Z\",\"Relative\":{\"Duration\":\"P25Y5M27DT1H36M38.123S\",\"Humanized\":\"25 years, 5 months from now\"},\"Tzdata\":\"" + goTzdata + "\"}",
+			},
+		},
+		{
+			name: "happy path - protobuf json",
+			args: []string{
+				"decode",
+				"-z",
+				"UTC=UTC",
+				"-f",
+				"protobuf",
+				`{"seconds": "1751074598", "nanos": 5}`,
+			},
+			expectedOutput: []string{
+				"UTC       Saturday, June 28, 2025    01:36:38.000000005    +00:00    UTC     no",
+			},
+		},
+		{
+			name: "happy path - msgpack",
+			args: []string{
+				"decode",
+				"-z",
+				"UTC=UTC",
+				"-f",
+				"msgpack-ext",
+				"d6ff685f4726",
+			},
+			expectedOutput: []string{
+				"UTC       Saturday, June 28, 2025    01:36:38    +00:00    UTC     no",
+			},
+		},
+		{
+			name: "missing format",
+			args: []string{
+				"decode",
+				"685f4726",
+			},
+			expectedError: `required flag(s) "format" not set`,
+		},
+		{
+			name: "invalid format",
+			args: []string{
+				"decode",
+				"-f",
+				"avro",
+				"685f4726",
+			},
+			expectedError: "invalid format flag: avro",
+		},
+		{
+			name: "malformed data",
+			args: []string{
+				"decode",
+				"-f",
+				"be64",
+				"685f4726",
+			},
+			expectedError: "could not decode timestamp: malformed timestamp: be64 is 8 bytes, got 4",
+		},
+		{
+			name: "timestamp after 9999",
+			args: []string{
+				"decode",
+				"-f",
+				"protobuf",
+				"-ojson",
+				`{"seconds":"9000000000000000000"}`,
+			},
+			expectedError: "could not decode timestamp: timestamp outside the years 0001 to 9999: year 285198648531",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
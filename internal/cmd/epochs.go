@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/DanStough/epok/parse"
+)
+
+// epochConfig is a user-defined epoch from the config file, e.g.
+//
+//	epochs:
+//	  events:
+//	    origin: 2015-01-01T00:00:00Z
+//	    unit: ms
+//	    description: event IDs
+type epochConfig struct {
+	// Origin is decoded by YAML as a time.Time, unless it's quoted.
+	Origin any
+	// Unit is the size of a tick, either a precision like "ms" or a duration like "100ns" or "24h".
+	Unit        string
+	Description string
+}
+
+// epochNames describes the values accepted by the epoch flags, e.g. "unix [posix], webkit [chrome]".
+func epochNames() string {
+	epochs := parse.Epochs()
+	names := make([]string, 0, len(epochs))
+	for _, e := range epochs {
+		if len(e.Aliases) == 0 {
+			names = append(names, e.Name)
+			continue
+		}
+		names = append(names, fmt.Sprintf("%s [%s]", e.Name, strings.Join(e.Aliases, ", ")))
+	}
+	return "valid epochs are " + strings.Join(names, ", ") + ", and the epochs defined in the config file"
+}
+
+// getEpoch looks up the epoch flag in the user-defined epochs from the config file, and then in the
+// built-in epochs.
+func getEpoch(custom []parse.Epoch) (parse.Epoch, error) {
+	str := viper.GetString("epoch")
+	for _, e := range custom {
+		if strings.EqualFold(e.Name, str) {
+			return e, nil
+		}
+	}
+
+	epoch, err := parse.LookupEpoch(str)
+	if err != nil {
+		return parse.Epoch{}, fmt.Errorf("invalid epoch flag: %s", str)
+	}
+	return epoch, nil
+}
+
+// customEpochs reads the user-defined epochs from the config file, ordered by name.
+func customEpochs() ([]parse.Epoch, error) {
+	var configs map[string]epochConfig
+	if err := viper.UnmarshalKey("epochs", &configs); err != nil {
+		return nil, fmt.Errorf("invalid epochs in config file: %w", err)
+	}
+
+	epochs := make([]parse.Epoch, 0, len(configs))
+	for name, config := range configs {
+		if _, err := parse.LookupEpoch(name); err == nil {
+			return nil, fmt.Errorf("invalid epoch %s in config file: the name is already used by a built-in epoch", name)
+		}
+
+		origin, err := configOrigin(config.Origin)
+		if err != nil {
+			return nil, fmt.Errorf("invalid epoch %s in config file: %w", name, err)
+		}
+		tick, err := configTick(config.Unit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid epoch %s in config file: %w", name, err)
+		}
+
+		description := config.Description
+		if description == "" {
+			description = fmt.Sprintf("%s since %s", config.Unit, origin.Format(time.RFC3339Nano))
+		}
+		epochs = append(epochs, parse.NewLinearEpoch(name, description, origin, tick))
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i].Name < epochs[j].Name })
+	return epochs, nil
+}
+
+func configOrigin(value any) (time.Time, error) {
+	switch origin := value.(type) {
+	case time.Time:
+		return origin, nil
+	case string:
+		t, err := parse.Date(origin, parse.DateOptions{Location: time.UTC})
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid origin %q: %w", origin, err)
+		}
+		return t, nil
+	case nil:
+		return time.Time{}, errors.New("missing origin")
+	default:
+		return time.Time{}, fmt.Errorf("invalid origin %v", origin)
+	}
+}
+
+func configTick(unit string) (time.Duration, error) {
+	if u, err := parse.ParseUnit(unit); err == nil {
+		switch u {
+		case parse.Seconds:
+			return time.Second, nil
+		case parse.Milliseconds:
+			return time.Millisecond, nil
+		case parse.Microseconds:
+			return time.Microsecond, nil
+		case parse.Nanoseconds:
+			return time.Nanosecond, nil
+		}
+	}
+
+	tick, err := time.ParseDuration(unit)
+	if err != nil || tick <= 0 {
+		return 0, fmt.Errorf("invalid unit %q", unit)
+	}
+	return tick, nil
+}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/table"
+	"github.com/spf13/cobra"
+
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/parse"
+)
+
+const explainNote = `Values too large for an int64 are read as nanoseconds up to 20 digits, then picoseconds up to 23 digits, then femtoseconds.
+Use --precision to skip inference, or --strict to reject values that read as dates before 1973 or after 2286.`
+
+// newExplainCmd creates the explain subcommand.
+func newExplainCmd() *cobra.Command {
+	explainCmd := &cobra.Command{
+		Use:   "explain",
+		Short: "show how parse infers the precision of a timestamp",
+		Long: `Use the explain command to print the table of thresholds used by the parse command
+to infer whether a timestamp is in seconds, milliseconds, microseconds or nanoseconds.`,
+		GroupID: groupIDEpochCommands,
+		Example: `# print the threshold table
+epok explain`,
+
+		Args: cobra.NoArgs,
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExplain(cmd)
+		},
+		SilenceUsage: true,
+	}
+
+	return explainCmd
+}
+
+func runExplain(cmd *cobra.Command) error {
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+
+	out := newExplainOutput(parse.Thresholds())
+
+	return writeOutput(cmd, mode, out)
+}
+
+type explainOutput struct {
+	Thresholds []Threshold
+}
+
+// Threshold is a range of epochs and the precision parse infers for them.
+// Everything is a string, since the bounds don't fit in a JSON number and
+// some of the dates are outside the four-digit years allowed by time.Time JSON.
+type Threshold struct {
+	Min       string
+	Max       string
+	Precision parse.Unit
+	Earliest  string // Earliest is always UTC.
+	Latest    string // Latest is always UTC.
+}
+
+func newExplainOutput(thresholds []parse.Threshold) *explainOutput {
+	out := &explainOutput{
+		Thresholds: make([]Threshold, 0, len(thresholds)),
+	}
+	for _, t := range thresholds {
+		earliest, _ := parse.IntWithOptions(t.Min, parse.Options{Unit: t.Unit})
+		latest, _ := parse.IntWithOptions(t.Max, parse.Options{Unit: t.Unit})
+		out.Thresholds = append(out.Thresholds, Threshold{
+			Min:       strconv.FormatInt(t.Min, 10),
+			Max:       strconv.FormatInt(t.Max, 10),
+			Precision: t.Unit,
+			Earliest:  earliest.In(time.UTC).Format(time.RFC3339),
+			Latest:    latest.In(time.UTC).Format(time.RFC3339),
+		})
+	}
+	return out
+}
+
+func (o *explainOutput) writeSimple(w io.Writer) error {
+	var errs error
+
+	tw := tabwriter.NewWriter(w, 0, 0, 4, ' ', tabwriter.TabIndent)
+	_, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", "FROM", "TO", "PRECISION", "EARLIEST", "LATEST")
+	errs = errors.Join(errs, err)
+
+	for _, t := range o.Thresholds {
+		_, err = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", t.Min, t.Max, t.Precision, t.Earliest, t.Latest)
+		errs = errors.Join(errs, err)
+	}
+	errs = errors.Join(errs, tw.Flush())
+
+	_, err = fmt.Fprintf(w, "\n%s\n", explainNote)
+	return errors.Join(errs, err)
+}
+
+func (o *explainOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+
+	rows := make([][]string, 0, len(o.Thresholds))
+	for _, t := range o.Thresholds {
+		rows = append(rows, []string{t.Min, t.Max, string(t.Precision), t.Earliest, t.Latest})
+	}
+
+	t := table.New().
+		Border(sheet.Table.BorderThickness).
+		BorderStyle(sheet.Table.Border).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			var style lipgloss.Style
+
+			switch {
+			case row == table.HeaderRow:
+				return sheet.Table.Header
+			case row%2 == 0:
+				style = sheet.Table.EvenRow
+			default:
+				style = sheet.Table.OddRow
+			}
+
+			if col < 2 {
+				style = style.Align(lipgloss.Right)
+			}
+			return style
+		}).
+		Headers("From", "To", "Precision", "Earliest", "Latest").
+		Rows(rows...)
+
+	var errs error
+	_, err := lipgloss.Fprintln(w, t)
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintln(w, sheet.TextSubdued.Italic(true).Render(explainNote))
+	return errors.Join(errs, err)
+}
+
+func (o *explainOutput) writeJson(w io.Writer) error {
+	bytes, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("could not marshal explain output JSON: %w", err)
+	}
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("could not write explain output: %w", err)
+	}
+	return nil
+}
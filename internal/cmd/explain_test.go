@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"testing"
+)
+
+const explainOutputSimple = `FROM                    TO                     PRECISION       EARLIEST                 LATEST
+-9223372036854775808    -9999999999999999      nanoseconds     1677-09-21T00:12:43Z     1969-09-07T06:13:20Z
+-9999999999999998       -100000000000000       microseconds    1653-02-10T06:13:20Z     1966-10-31T14:13:20Z
+-99999999999999         -30000000000           milliseconds    -1199-02-15T14:13:20Z    1969-01-18T18:40:00Z
+-29999999999            99999999999            seconds         1019-05-04T18:40:01Z     5138-11-16T09:46:39Z
+100000000000            99999999999999         milliseconds    1973-03-03T09:46:40Z     5138-11-16T09:46:39Z
+100000000000000         9999999999999998       microseconds    1973-03-03T09:46:40Z     2286-11-20T17:46:39Z
+9999999999999999        9223372036854775807    nanoseconds     1970-04-26T17:46:39Z     2262-04-11T23:47:16Z`
+
+func Test_Explain(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "happy path - simple",
+			args: []string{
+				"explain",
+			},
+			expectedOutput: []string{
+				explainOutputSimple,
+				"Values too large for an int64 are read as nanoseconds up to 20 digits, then picoseconds up to 23 digits, then femtoseconds.",
+			},
+		},
+		{
+			name: "happy path - json output",
+			args: []string{
+				"explain",
+				"-ojson",
+			},
+			expectedOutput: []string{
+				"{\"Min\":\"-29999999999\",\"Max\":\"99999999999\",\"Precision\":\"seconds\",\"Earliest\":\"1019-05-04T18:40:01Z\",\"Latest\":\"5138-11-16T09:46:39Z\"}",
+			},
+		},
+		{
+			name: "no arguments allowed",
+			args: []string{
+				"explain",
+				"1751074598",
+			},
+			expectedError: "unknown command \"1751074598\" for \"epok explain\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
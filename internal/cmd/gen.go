@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/DanStough/epok/id"
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/parse"
+)
+
+// generatedKinds are the types of ID the gen command can build.
+var generatedKinds = []id.Kind{id.UUIDv7, id.ULID, id.KSUID, id.Snowflake, id.ObjectID}
+
+// newGenCmd creates the gen subcommand.
+func newGenCmd() *cobra.Command {
+	kinds := make([]string, 0, len(generatedKinds))
+	for _, k := range generatedKinds {
+		kinds = append(kinds, string(k))
+	}
+
+	genCmd := &cobra.Command{
+		Use:   "gen type",
+		Short: "generate a time-ordered ID for an instant",
+		Long: `Use the gen command to build an identifier whose embedded timestamp is a chosen instant, the
+reverse of the id command. Valid types are ` + strings.Join(kinds, ", ") + `.
+
+The rest of the ID is random, unless --min or --max is set. They build the smallest and largest IDs
+for the instant, which are the boundaries of a range scan.`,
+		GroupID: groupIDEpochCommands,
+		Example: `# generate a UUIDv7 for the current instant
+epok gen uuidv7
+
+# generate the smallest ULID at midnight
+epok gen ulid --at 1751068800 --min
+
+# generate the largest Discord snowflake for an instant in milliseconds
+epok gen snowflake --snowflake discord --at 1751074598123 --max`,
+
+		Args: cobra.ExactArgs(1),
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGen(cmd, args)
+		},
+		SilenceUsage: true,
+	}
+
+	genCmd.Flags().String("at", "now", "unix timestamp embedded in the ID. Use 'now' for the current instant.")
+	genCmd.Flags().Bool("min", false, "generate the smallest ID for the instant")
+	genCmd.Flags().Bool("max", false, "generate the largest ID for the instant")
+	genCmd.MarkFlagsMutuallyExclusive("min", "max")
+	addSnowflakeFlags(genCmd)
+
+	return genCmd
+}
+
+func runGen(cmd *cobra.Command, args []string) error {
+	kind, err := id.ParseKind(args[0])
+	if err != nil || kind == id.Auto {
+		return fmt.Errorf("invalid type: %s", args[0])
+	}
+
+	at := time.Now()
+	if s := viper.GetString("at"); s != "now" {
+		at, err = parse.String(s)
+		if err != nil {
+			return fmt.Errorf("invalid at flag: %w", err)
+		}
+	}
+
+	layout, err := getSnowflakeLayout(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts := id.GenerateOptions{Snowflake: layout}
+	switch {
+	case viper.GetBool("min"):
+		opts.Fill = id.FillMin
+	case viper.GetBool("max"):
+		opts.Fill = id.FillMax
+	}
+
+	generated, err := id.Generate(kind, at, opts)
+	if err != nil {
+		return fmt.Errorf("could not generate ID: %w", err)
+	}
+
+	// Decoding the ID reports the time it actually holds, after truncation to its precision.
+	result, err := id.Decode(generated, id.Options{Kind: kind, Snowflake: layout})
+	if err != nil {
+		return fmt.Errorf("could not decode generated ID: %w", err)
+	}
+
+	out := &genOutput{
+		ID:   generated,
+		Type: result.Kind,
+		Time: result.Time.In(time.UTC),
+	}
+
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(cmd, mode, out)
+}
+
+type genOutput struct {
+	ID   string
+	Type id.Kind
+	Time time.Time // Time is always UTC time, since it shows up across all JSON outputs.
+}
+
+func (o *genOutput) writeSimple(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\n", o.ID)
+	return err
+}
+
+func (o *genOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+
+	label := fmt.Sprintf("%s:", strings.ToUpper(string(o.Type)))
+	_, err := fmt.Fprintln(w, sheet.Keyword.Render(label), sheet.Text.Render(o.ID))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, sheet.Keyword.Render("Time:"), sheet.TextSubdued.Render(o.Time.Format(time.RFC3339Nano)))
+	return err
+}
+
+func (o *genOutput) writeJson(w io.Writer) error {
+	bytes, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("could not marshal gen output JSON: %w", err)
+	}
+	bytes = append(bytes, '\n')
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("could not write gen output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func Test_Gen(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "happy path - smallest ulid at midnight",
+			args: []string{
+				"gen",
+				"ulid",
+				"--at",
+				"1751068800",
+				"--min",
+			},
+			expectedOutput: []string{
+				"01JYSXAX000000000000000000\n",
+			},
+		},
+		{
+			name: "happy path - now",
+			args: []string{
+				"gen",
+				"objectid",
+				"--max",
+				"-ojson",
+			},
+			expectedOutput: []string{
+				"{\"ID\":\"386d4380ffffffffffffffff\",\"Type\":\"objectid\",\"Time\":\"2000-01-01T00:00:00Z\"}\n",
+			},
+		},
+		{
+			name: "happy path - uuid is version 7",
+			args: []string{
+				"gen",
+				"uuid",
+				"--at",
+				"1751074598123",
+				"--min",
+				"-ojson",
+			},
+			expectedOutput: []string{
+				"{\"ID\":\"0197b42d-eceb-7000-8000-000000000000\",\"Type\":\"uuidv7\",\"Time\":\"2025-06-28T01:36:38.123Z\"}\n",
+			},
+		},
+		{
+			name: "happy path - discord snowflake",
+			args: []string{
+				"gen",
+				"snowflake",
+				"--snowflake",
+				"discord",
+				"--at",
+				"1462015105796",
+				"--min",
+			},
+			expectedOutput: []string{
+				"175928847298985984\n",
+			},
+		},
+		{
+			name: "before the snowflake epoch",
+			args: []string{
+				"gen",
+				"snowflake",
+			},
+			expectedError: "could not generate ID: time can't be represented by the ID: must be after the snowflake epoch 2010-11-04T01:42:54Z",
+		},
+		{
+			name: "invalid type",
+			args: []string{
+				"gen",
+				"uuidv1",
+			},
+			expectedError: "could not generate ID: unknown ID type: uuidv1 can't be generated",
+		},
+		{
+			name: "auto type",
+			args: []string{
+				"gen",
+				"auto",
+			},
+			expectedError: "invalid type: auto",
+		},
+		{
+			name: "invalid at",
+			args: []string{
+				"gen",
+				"ulid",
+				"--at",
+				"tomorrow",
+			},
+			expectedError: "invalid at flag: invalid timestamp format",
+		},
+		{
+			name: "min and max",
+			args: []string{
+				"gen",
+				"ulid",
+				"--min",
+				"--max",
+			},
+			expectedError: "if any flags in the group [min max] are set none of the others can be; [max min] were all set",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
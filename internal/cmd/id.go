@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/DanStough/epok/id"
+	"github.com/DanStough/epok/internal/i18n"
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/natural"
+	"github.com/DanStough/epok/parse"
+)
+
+// newIDCmd creates the id subcommand.
+func newIDCmd() *cobra.Command {
+	idCmd := &cobra.Command{
+		Use:   "id identifier",
+		Short: "extract the timestamp from a time-ordered ID",
+		Long: `Use the id command to find out when an identifier was minted. The type of the ID is detected
+from its format: Snowflakes, UUID versions 1, 6 and 7, ULIDs, KSUIDs and MongoDB ObjectIDs are supported.
+
+Snowflakes don't record their epoch, so Twitter's layout is assumed unless another is chosen.`,
+		GroupID: groupIDEpochCommands,
+		Example: `# decode a UUIDv7
+epok id 017f22e2-79b0-7cc3-98c4-dc0c0c07398f
+
+# decode a Discord snowflake
+epok id 175928847299117063 --snowflake discord
+
+# decode a snowflake with an in-house epoch and 20 bits of worker and sequence numbers
+epok id 4194304000 --snowflake-epoch 1577836800000 --snowflake-shift 20`,
+
+		Args: cobra.MaximumNArgs(1),
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runID(cmd, args)
+		},
+		SilenceUsage: true,
+	}
+
+	kinds := make([]string, 0, len(id.Kinds))
+	for _, k := range id.Kinds {
+		kinds = append(kinds, string(k))
+	}
+
+	addLocalesFlag(idCmd)
+	addRelativeFlags(idCmd)
+	idCmd.Flags().String("type", "auto",
+		"type of the ID. By default it is detected from the format. Valid types are uuid, "+strings.Join(kinds, ", "))
+	addSnowflakeFlags(idCmd)
+	return idCmd
+}
+
+// addSnowflakeFlags adds the flags for the layout of snowflake IDs, which are read by getSnowflakeLayout.
+func addSnowflakeFlags(cmd *cobra.Command) {
+	layouts := make([]string, 0, len(id.SnowflakeLayouts))
+	for _, l := range id.SnowflakeLayouts {
+		layouts = append(layouts, l.Name)
+	}
+
+	cmd.Flags().String("snowflake", id.TwitterSnowflake.Name,
+		"layout of snowflake IDs. Valid layouts are "+strings.Join(layouts, ", "))
+	cmd.Flags().String("snowflake-epoch", "",
+		"override the epoch of the snowflake layout, e.g. 1420070400000")
+	cmd.Flags().Uint("snowflake-shift", id.TwitterSnowflake.Shift,
+		"override the number of bits below the timestamp of the snowflake layout")
+}
+
+func runID(cmd *cobra.Command, args []string) error {
+	var input string
+	var err error
+	if len(args) == 0 {
+		input, err = readFromStdin(cmd)
+		if err != nil {
+			return err
+		}
+	} else {
+		input = args[0]
+	}
+
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+
+	locales, err := getLocales(cmd)
+	if err != nil {
+		return err
+	}
+
+	lang, err := getLanguage()
+	if err != nil {
+		return err
+	}
+
+	relative, err := getRelativeOptions()
+	if err != nil {
+		return err
+	}
+
+	kind, err := id.ParseKind(viper.GetString("type"))
+	if err != nil {
+		return fmt.Errorf("invalid type flag: %s", viper.GetString("type"))
+	}
+	layout, err := getSnowflakeLayout(cmd)
+	if err != nil {
+		return err
+	}
+
+	result, err := id.Decode(input, id.Options{Kind: kind, Snowflake: layout})
+	if err != nil {
+		return fmt.Errorf("could not decode ID: %w", err)
+	}
+
+	out := newIDOutput(strings.TrimSpace(input), result, locales, relative, lang)
+
+	return writeOutput(cmd, mode, out)
+}
+
+// getSnowflakeLayout reads the snowflake layout flags. The epoch and shift override the named layout.
+func getSnowflakeLayout(cmd *cobra.Command) (id.SnowflakeLayout, error) {
+	layout, err := id.LookupSnowflakeLayout(viper.GetString("snowflake"))
+	if err != nil {
+		return id.SnowflakeLayout{}, fmt.Errorf("invalid snowflake flag: %s", viper.GetString("snowflake"))
+	}
+
+	if epoch := viper.GetString("snowflake_epoch"); epoch != "" {
+		layout.Epoch, err = parse.String(epoch)
+		if err != nil {
+			return id.SnowflakeLayout{}, fmt.Errorf("invalid snowflake-epoch flag: %w", err)
+		}
+	}
+	if cmd.Flags().Changed("snowflake-shift") {
+		layout.Shift = viper.GetUint("snowflake_shift")
+	}
+	return layout, nil
+}
+
+type idOutput struct {
+	ID      string
+	Type    id.Kind
+	Locales []Locale
+
+	// Derived
+	Now      time.Time // Now is always UTC time, since it shows up across all JSON outputs.
+	Relative Relative
+	Tzdata   string `json:",omitempty"`
+
+	lang i18n.Language
+}
+
+func newIDOutput(input string, result id.Result, localesByTz map[string]localeZone, relative natural.HumanizeOptions, lang i18n.Language) *idOutput {
+	now := time.Now().In(time.UTC)
+	return &idOutput{
+		ID:       input,
+		Type:     result.Kind,
+		Locales:  newLocales(result.Time, localesByTz),
+		Now:      now,
+		Relative: newRelative(result.Time, now, relative, lang),
+		Tzdata:   tzdataVersion(),
+
+		lang: lang,
+	}
+}
+
+func (o *idOutput) writeSimple(w io.Writer) error {
+	var errs error
+
+	tw := tabwriter.NewWriter(w, 0, 0, 4, ' ', tabwriter.TabIndent)
+	err := writeLocaleRows(tw, o.Locales, o.lang)
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintln(tw)
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintf(tw, "Type: %s\n", o.Type)
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintf(tw, "Relative: %s\n", o.Relative.Humanized)
+	errs = errors.Join(errs, err)
+
+	return errors.Join(errs, tw.Flush())
+}
+
+func (o *idOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+
+	var errs error
+	_, err := lipgloss.Fprintln(w, localeTable(sheet, o.Locales, o.lang))
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintln(w, sheet.Keyword.Render("Type:"), sheet.Text.Render(string(o.Type)))
+	errs = errors.Join(errs, err)
+
+	_, err = fmt.Fprintln(w, sheet.Keyword.Render("Relative:"), o.Relative.render(sheet))
+	errs = errors.Join(errs, err)
+
+	return errs
+}
+
+func (o *idOutput) writeJson(w io.Writer) error {
+	bytes, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("could not marshal id output JSON: %w", err)
+	}
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("could not write id output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func Test_ID(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "happy path - uuidv7",
+			args: []string{
+				"id",
+				"-z",
+				"UTC=UTC",
+				"017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+			},
+			expectedOutput: []string{
+				`LOCALE    DATE                          TIME        OFFSET    ZONE    DST
+UTC       Tuesday, February 22, 2022    19:22:22    +00:00    UTC     no
+
+Type: uuidv7
+Relative: 22 years, 1 month from now`,
+			},
+		},
+		{
+			name: "happy path - stdin",
+			args: []string{
+				"id",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			in: "507f1f77bcf86cd799439011\n",
+			expectedOutput: []string{
+				"{\"ID\":\"507f1f77bcf86cd799439011\",\"Type\":\"objectid\",\"Locales\":[{\"Name\":\"UTC\",\"Time\":\"2012-10-17T21:13:27Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}],\"Now\":\"2000-01-01T00:00:00Z\",\"Relative\":{\"Duration\":\"P12Y9M16DT21H13M27S\",\"Humanized\":\"12 years, 9 months from now\"},\"Tzdata\":\"" + goTzdata + "\"}",
+			},
+		},
+		{
+			name: "happy path - discord snowflake",
+			args: []string{
+				"id",
+				"-z",
+				"UTC=UTC",
+				"--snowflake",
+				"discord",
+				"175928847299117063",
+			},
+			expectedOutput: []string{
+				"UTC       Saturday, April 30, 2016    11:18:25.796    +00:00    UTC     no",
+			},
+		},
+		{
+			name: "happy path - custom snowflake layout",
+			args: []string{
+				"id",
+				"-z",
+				"UTC=UTC",
+				"--snowflake-epoch",
+				"1577836800000",
+				"--snowflake-shift",
+				"20",
+				"1048576000",
+			},
+			expectedOutput: []string{
+				"UTC       Wednesday, January 1, 2020    00:00:01    +00:00    UTC     no",
+			},
+		},
+		{
+			name: "happy path - forced type",
+			args: []string{
+				"id",
+				"-z",
+				"UTC=UTC",
+				"--type",
+				"ulid",
+				"01arz3ndektsv4rrffq69g5fav",
+			},
+			expectedOutput: []string{
+				"Type: ulid",
+			},
+		},
+		{
+			name: "uuid without a timestamp",
+			args: []string{
+				"id",
+				"f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			},
+			expectedError: "could not decode ID: ID has no timestamp: UUID version 4",
+		},
+		{
+			name: "invalid type",
+			args: []string{
+				"id",
+				"--type",
+				"guid",
+				"f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			},
+			expectedError: "invalid type flag: guid",
+		},
+		{
+			name: "invalid snowflake layout",
+			args: []string{
+				"id",
+				"--snowflake",
+				"instagram",
+				"175928847299117063",
+			},
+			expectedError: "invalid snowflake flag: instagram",
+		},
+		{
+			name: "unknown format",
+			args: []string{
+				"id",
+				"banana",
+			},
+			expectedError: "could not decode ID: unrecognized ID format",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/DanStough/epok/internal/i18n"
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/tz"
+)
+
+// Locale is an instant shown in a named time zone.
+type Locale struct {
+	Name string
+	Time time.Time
+
+	// Offset, Abbreviation and DST describe the zone at Time, like "-04:00", "EDT" and true.
+	Offset       string
+	Abbreviation string
+	DST          bool
+
+	// Hours and Working are the working hours of a team member, like "09:00-17:00 Mon-Fri", and whether
+	// Time is inside them. They're only set for the locales of a team.
+	Hours   string `json:",omitempty"`
+	Working *bool  `json:",omitempty"`
+
+	subnanos string // subnanos are the digits finer than a nanosecond, shown after those of Time.
+}
+
+// addLocalesFlag adds the flags for the locales shown in the locale table: a map of locales, or a team from
+// the config file.
+func addLocalesFlag(cmd *cobra.Command) {
+	defaultLocales := map[string]string{
+		"Local": "Local",
+		"UTC":   "UTC",
+	}
+
+	cmd.Flags().StringToStringP("timezone", "z", defaultLocales,
+		"override the map of locales:timezones. "+
+			"Use 'Local' for system time. "+timezoneSpecifiers)
+	cmd.Flags().StringP("team", "t", "",
+		"show the locales of a team defined in the config file, with their working hours. "+
+			"Set team in the config file to use one by default.")
+}
+
+// localeZone is the zone of a locale, and the working hours of team members.
+type localeZone struct {
+	Location *time.Location
+	Hours    *WorkingHours
+}
+
+// getLocales loads the time zones of the locales flag, or of a team. The timezone flag takes precedence
+// over a team set in the config file, so it can still be used with a default team.
+func getLocales(cmd *cobra.Command) (map[string]localeZone, error) {
+	team := viper.GetString("team")
+	timezoneChanged := cmd.Flags().Changed("timezone")
+	if cmd.Flags().Changed("team") && timezoneChanged {
+		return nil, errors.New("the timezone and team flags can't be used together")
+	}
+	if team != "" && !timezoneChanged {
+		return getTeam(team)
+	}
+
+	timezones := viper.GetStringMapString("timezone")
+	if len(timezones) == 0 {
+		return nil, errors.New("must specify at least one locale timezone")
+	}
+
+	locales := make(map[string]localeZone, len(timezones))
+	for name, timezone := range timezones {
+		loc, err := tz.Resolve(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %s for locale %s: %w", timezone, name, err)
+		}
+		locales[name] = localeZone{Location: loc}
+	}
+	return locales, nil
+}
+
+// newLocales shows t in every locale, ordered by name.
+func newLocales(t time.Time, localesByTz map[string]localeZone) []Locale {
+	locales := make([]Locale, 0, len(localesByTz))
+	for name, lz := range localesByTz {
+		zone := tz.At(lz.Location, t)
+		locale := Locale{
+			Name:         name,
+			Time:         t.In(lz.Location),
+			Offset:       tz.FormatOffset(zone.Offset),
+			Abbreviation: zone.Abbreviation,
+			DST:          zone.DST,
+		}
+		if lz.Hours != nil {
+			working := lz.Hours.Contains(locale.Time)
+			locale.Hours, locale.Working = lz.Hours.String(), &working
+		}
+		locales = append(locales, locale)
+	}
+	sort.Slice(locales, func(i, j int) bool { return locales[i].Name < locales[j].Name })
+	return locales
+}
+
+// withSubnanoseconds shows the digits finer than a nanosecond in every locale.
+func withSubnanoseconds(locales []Locale, subnanos string) []Locale {
+	for i := range locales {
+		locales[i].subnanos = subnanos
+	}
+	return locales
+}
+
+// formatTime writes the time of day, with any digits finer than a nanosecond after the fraction.
+func (l Locale) formatTime() string {
+	if l.subnanos == "" {
+		return l.Time.Format(TimeOfDayNano)
+	}
+	// Keep all nine digits of the fraction, so the extra digits land in the right place.
+	return l.Time.Format("15:04:05.000000000") + l.subnanos
+}
+
+// row is the cells of the locale in the locale table. The working hours column is only shown for teams.
+func (l Locale) row(lang i18n.Language, working bool) []string {
+	row := []string{l.Name, lang.Date(l.Time), l.formatTime(), l.Offset, l.Abbreviation, lang.YesNo(l.DST)}
+	if !working {
+		return row
+	}
+	if l.Working == nil {
+		return append(row, "")
+	}
+	return append(row, lang.YesNo(*l.Working))
+}
+
+// hasWorkingHours reports whether any locale has working hours, so the table needs a column for them.
+func hasWorkingHours(locales []Locale) bool {
+	for _, locale := range locales {
+		if locale.Working != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// localeHeaders are the headers of the locale table, with the working hours column only for teams.
+func localeHeaders(lang i18n.Language, working bool) []string {
+	headers := lang.Headers()
+	if !working {
+		return headers[:len(headers)-1]
+	}
+	return headers
+}
+
+// writeLocaleRows writes the locale table for the simple output mode.
+func writeLocaleRows(w io.Writer, locales []Locale, lang i18n.Language) error {
+	var errs error
+
+	working := hasWorkingHours(locales)
+	_, err := fmt.Fprintln(w, strings.ToUpper(strings.Join(localeHeaders(lang, working), "\t")))
+	errs = errors.Join(errs, err)
+
+	for _, locale := range locales {
+		_, err = fmt.Fprintln(w, strings.Join(locale.row(lang, working), "\t"))
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
+
+// localeTable renders the locale table for the pretty output mode.
+func localeTable(sheet *styles.Sheet, locales []Locale, lang i18n.Language) *table.Table {
+	working := hasWorkingHours(locales)
+	rows := make([][]string, 0, len(locales))
+	for _, locale := range locales {
+		rows = append(rows, locale.row(lang, working))
+	}
+
+	localeWidth, dateWidth := 8, 30
+	for _, row := range rows {
+		localeWidth = max(localeWidth, lipgloss.Width(row[0]))
+		// Some languages have longer dates, like "quinta-feira, 28 de setembro de 2025".
+		dateWidth = max(dateWidth, lipgloss.Width(row[1]))
+	}
+
+	return table.New().
+		Border(sheet.Table.BorderThickness).
+		BorderStyle(sheet.Table.Border).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			var style lipgloss.Style
+
+			switch {
+			case row == table.HeaderRow:
+				return sheet.Table.Header
+			case row%2 == 0:
+				style = sheet.Table.EvenRow
+			default:
+				style = sheet.Table.OddRow
+			}
+
+			switch col {
+			case 0:
+				style = style.Width(localeWidth + 2) // include padding
+			case 1:
+				style = style.Width(dateWidth + 2) // include padding
+			case 2:
+				style = style.Width(26)
+			}
+
+			return style
+		}).
+		Headers(localeHeaders(lang, working)...).
+		Rows(rows...)
+}
+
+// addTransitionWindowFlag adds the flag for how close to a daylight saving transition a time is warned about.
+func addTransitionWindowFlag(cmd *cobra.Command) {
+	cmd.Flags().Duration("transition-window", 12*time.Hour,
+		"warn when a locale changes its offset, like at the start or end of daylight saving time, "+
+			"within this long of the time. Use 0 to turn off the warnings.")
+}
+
+// getTransitionWindow reads the transition window flag.
+func getTransitionWindow() (time.Duration, error) {
+	window := viper.GetDuration("transition_window")
+	if window < 0 {
+		return 0, fmt.Errorf("invalid transition-window flag: %s, must not be negative", window)
+	}
+	return window, nil
+}
+
+// transitionWarnings warns about the locales whose wall-clock time is ambiguous, or that are within window of
+// a transition, when the wall-clock times around it are skipped or repeated.
+func transitionWarnings(locales []Locale, window time.Duration) []string {
+	if window == 0 {
+		return nil
+	}
+
+	var warnings []string
+	for _, locale := range locales {
+		loc := locale.Time.Location()
+
+		if transition, ok := tz.Ambiguous(loc, locale.Time); ok {
+			warnings = append(warnings, fmt.Sprintf("%s: %s happens twice, as %s and %s, so it's ambiguous without the offset",
+				locale.Name, locale.Time.Format(time.TimeOnly), transition.Before.Abbreviation, transition.After.Abbreviation))
+			continue
+		}
+
+		transition, ok := tz.Nearest(loc, locale.Time, window)
+		if !ok {
+			continue
+		}
+		when := "before"
+		if !transition.At.After(locale.Time) {
+			when = "after"
+		}
+		warning := fmt.Sprintf("%s: %s %s %s at %s (%s)", locale.Name,
+			formatDuration(transition.At.Sub(locale.Time).Abs()), when, describeTransition(transition),
+			transition.At.Format(time.RFC3339), newZoneTransition(transition))
+		if from, to, effect := wallTimes(transition); effect != "" {
+			warning += fmt.Sprintf(", wall times from %s to %s are %s", from, to, effect)
+		}
+		warnings = append(warnings, warning)
+	}
+	return warnings
+}
+
+// describeTransition names a transition, like "daylight saving time starts".
+func describeTransition(transition tz.Transition) string {
+	switch {
+	case !transition.Before.DST && transition.After.DST:
+		return "daylight saving time starts"
+	case transition.Before.DST && !transition.After.DST:
+		return "daylight saving time ends"
+	default:
+		return "the offset changes"
+	}
+}
+
+// wallTimes returns the wall-clock times a transition skips or repeats, and which it does, like "02:00",
+// "03:00" and "skipped". The effect is empty when the offset doesn't change.
+func wallTimes(transition tz.Transition) (from, to, effect string) {
+	before := transition.At.In(time.FixedZone("", transition.Before.Offset)).Format("15:04")
+	after := transition.At.In(time.FixedZone("", transition.After.Offset)).Format("15:04")
+	switch {
+	case transition.Shift() > 0:
+		return before, after, "skipped"
+	case transition.Shift() < 0:
+		return after, before, "repeated"
+	default:
+		return "", "", ""
+	}
+}
+
+// formatDuration writes a duration to the minute, like "1h30m" or "45m".
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d < time.Minute {
+		return "under 1m"
+	}
+	s := strings.TrimSuffix(d.String(), "0s")
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
@@ -0,0 +1,371 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/DanStough/epok/internal/i18n"
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/natural"
+	"github.com/DanStough/epok/tz"
+)
+
+// newMeetCmd creates the meet subcommand.
+func newMeetCmd() *cobra.Command {
+	meetCmd := &cobra.Command{
+		Use:   "meet",
+		Short: "find meeting times when every locale is working",
+		Long: `Use the meet command to plan a meeting across locales. It shows the hours of a day side by side in
+every locale, marks the hours each one is working, and lists the times a meeting fits inside everyone's
+working hours. Meetings can start every 30 minutes, or more often when they're shorter.
+
+Team members use the working hours from the config file, and other locales use the working-hours and
+working-days flags. The hours of the day follow the base zone, unless the date names its own zone.`,
+		GroupID: groupIDTimezoneCommands,
+		Example: `# plan a meeting between three offices tomorrow
+epok meet --zones NYC=America/New_York,London=Europe/London,Tokyo=Asia/Tokyo --date tomorrow
+
+# find 30 minute slots for a team from the config file
+epok meet --team platform --date 2025-09-29 --duration 30m
+
+# use a longer working day, with the hours of the day in Berlin
+epok meet -z SF=PT,Berlin=CET --working-hours 08:00-18:00 --base Europe/Berlin`,
+
+		Args: cobra.NoArgs,
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runMeet(cmd)
+		},
+		SilenceUsage: true,
+	}
+
+	addLocalesFlag(meetCmd)
+	// zones reads better than timezone for a list of locales, so it's accepted too.
+	meetCmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == "zones" {
+			name = "timezone"
+		}
+		return pflag.NormalizedName(name)
+	})
+	meetCmd.Flags().StringP("date", "d", "today",
+		"the day to plan, like 2025-09-29, tomorrow or next monday.")
+	meetCmd.Flags().String("base", "Local",
+		"the zone of the hours of the day. Use 'Local' for system time. "+timezoneSpecifiers)
+	meetCmd.Flags().Duration("duration", time.Hour, "the length of the meeting.")
+	meetCmd.Flags().String("working-hours", defaultWorkingHours,
+		"the working hours of locales that aren't team members, like 08:30-17:30.")
+	meetCmd.Flags().String("working-days", defaultWorkingDays,
+		"the working days of locales that aren't team members, like mon-fri or sun-thu.")
+	return meetCmd
+}
+
+func runMeet(cmd *cobra.Command) error {
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+	lang, err := getLanguage()
+	if err != nil {
+		return err
+	}
+
+	base := viper.GetString("base")
+	loc, err := tz.Resolve(base)
+	if err != nil {
+		return fmt.Errorf("invalid base %s: %w", base, err)
+	}
+
+	date := viper.GetString("date")
+	day, err := natural.Parse(date, natural.Options{Location: loc, LoadZone: tz.Resolve})
+	if err != nil {
+		return fmt.Errorf("invalid date flag: %s: %w", date, err)
+	}
+
+	duration := viper.GetDuration("duration")
+	if duration <= 0 || duration > 24*time.Hour {
+		return fmt.Errorf("invalid duration flag: %s, must be more than 0 and at most 24h", duration)
+	}
+
+	hours, err := parseWorkingHours(viper.GetString("working_hours"), viper.GetString("working_days"))
+	if err != nil {
+		return fmt.Errorf("invalid working hours: %w", err)
+	}
+
+	locales, err := getLocales(cmd)
+	if err != nil {
+		return err
+	}
+	for name, lz := range locales {
+		if lz.Hours == nil {
+			locales[name] = localeZone{Location: lz.Location, Hours: &hours}
+		}
+	}
+
+	out := newMeetOutput(natural.StartOf(day, natural.Day), duration, locales)
+	out.lang = lang
+	return writeOutput(cmd, mode, out)
+}
+
+// MeetOutput is the grid of the hours of a day in every locale, and the meeting slots inside everyone's
+// working hours.
+type MeetOutput struct {
+	Date     string // Date is the day of the grid in Zone, like "2025-09-29".
+	Zone     string
+	Duration string
+	Locales  []MeetLocale
+	Hours    []MeetHour
+	Slots    []MeetSlot
+	Tzdata   string `json:",omitempty"`
+
+	// Derived
+	day  time.Time
+	lang i18n.Language
+}
+
+// MeetLocale is a row of the grid.
+type MeetLocale struct {
+	Name  string
+	Zone  string
+	Hours string // Hours are the working hours, like "09:00-17:00 Mon-Fri".
+}
+
+// MeetHour is a column of the grid, an hour of the day in the zone of the grid.
+type MeetHour struct {
+	Start    time.Time
+	Locales  []MeetTime // Locales are in the same order as the locales of the grid.
+	Everyone bool       // Everyone is whether every locale is working for the whole hour.
+}
+
+// MeetTime is the wall time of a locale at the start of an hour.
+type MeetTime struct {
+	Name    string
+	Time    time.Time
+	Working bool // Working is whether the locale is working for the whole hour.
+}
+
+// MeetSlot is a time a meeting fits inside everyone's working hours.
+type MeetSlot struct {
+	Epoch string
+	Start time.Time
+	End   time.Time
+}
+
+// newMeetOutput builds the grid for the day starting at day. A day with a daylight saving transition in
+// the zone of the grid has 23 or 25 hours.
+func newMeetOutput(day time.Time, duration time.Duration, localesByTz map[string]localeZone) *MeetOutput {
+	names := make([]string, 0, len(localesByTz))
+	for name := range localesByTz {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := &MeetOutput{
+		Date:     day.Format(time.DateOnly),
+		Zone:     day.Location().String(),
+		Duration: formatDuration(duration),
+		Tzdata:   tzdataVersion(),
+		day:      day,
+	}
+	for _, name := range names {
+		lz := localesByTz[name]
+		out.Locales = append(out.Locales, MeetLocale{Name: name, Zone: lz.Location.String(), Hours: lz.Hours.String()})
+	}
+
+	end := natural.Add(day, 1, natural.Day)
+	for start := day; start.Before(end); start = start.Add(time.Hour) {
+		hour := MeetHour{Start: start, Everyone: everyoneWorking(localesByTz, start, start.Add(time.Hour))}
+		for _, name := range names {
+			lz := localesByTz[name]
+			local := start.In(lz.Location)
+			working := workingBetween(lz, start, start.Add(time.Hour))
+			hour.Locales = append(hour.Locales, MeetTime{Name: name, Time: local, Working: working})
+		}
+		out.Hours = append(out.Hours, hour)
+	}
+
+	// Slots start more often than the hours of the grid, so short meetings can start on the half hour.
+	step := min(duration, slotStep)
+	for start := day; start.Before(end); start = start.Add(step) {
+		if everyoneWorking(localesByTz, start, start.Add(duration)) {
+			out.Slots = append(out.Slots, MeetSlot{
+				Epoch: strconv.FormatInt(start.Unix(), 10),
+				Start: start,
+				End:   start.Add(duration),
+			})
+		}
+	}
+	return out
+}
+
+// slotStep is the longest time between the starts of meeting slots.
+const slotStep = 30 * time.Minute
+
+// everyoneWorking reports whether every locale is inside their working hours from start until end.
+func everyoneWorking(localesByTz map[string]localeZone, start, end time.Time) bool {
+	for _, lz := range localesByTz {
+		if !workingBetween(lz, start, end) {
+			return false
+		}
+	}
+	return true
+}
+
+// workingBetween reports whether a locale is inside their working hours from start until end. Working hours
+// and offsets change on the minute, so it's checked every minute.
+func workingBetween(lz localeZone, start, end time.Time) bool {
+	for t := start; t.Before(end); t = t.Add(time.Minute) {
+		if !lz.Hours.Contains(t.In(lz.Location)) {
+			return false
+		}
+	}
+	return true
+}
+
+// clock writes the wall time of a cell, with the minutes only for zones that aren't on the hour.
+func (t MeetTime) clock() string {
+	if t.Time.Minute() == 0 {
+		return t.Time.Format("15")
+	}
+	return t.Time.Format("15:04")
+}
+
+func (o *MeetOutput) headers() []string {
+	headers := []string{o.lang.Headers()[0]}
+	for _, hour := range o.Hours {
+		headers = append(headers, hour.Start.Format("15"))
+	}
+	return headers
+}
+
+func (o *MeetOutput) summary() string {
+	return fmt.Sprintf("%s (%s)", o.lang.Date(o.day), o.Zone)
+}
+
+func (o *MeetOutput) slotRows() [][]string {
+	rows := make([][]string, 0, len(o.Slots))
+	for _, slot := range o.Slots {
+		rows = append(rows, []string{slot.Epoch, slot.Start.Format(time.RFC3339), slot.End.Format(time.RFC3339)})
+	}
+	return rows
+}
+
+func (o *MeetOutput) noSlots() string {
+	return fmt.Sprintf("No %s slots when every locale is working", o.Duration)
+}
+
+// writeSimple marks working hours with "*", and the hours everyone is working in the last row.
+func (o *MeetOutput) writeSimple(w io.Writer) error {
+	var errs error
+	_, err := fmt.Fprintf(w, "Date: %s\n\n", o.summary())
+	errs = errors.Join(errs, err)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', tabwriter.TabIndent)
+	_, err = fmt.Fprintln(tw, strings.ToUpper(strings.Join(o.headers(), "\t")))
+	errs = errors.Join(errs, err)
+
+	for i, locale := range o.Locales {
+		row := []string{locale.Name}
+		for _, hour := range o.Hours {
+			cell := hour.Locales[i].clock()
+			if hour.Locales[i].Working {
+				cell += "*"
+			}
+			row = append(row, cell)
+		}
+		_, err = fmt.Fprintln(tw, strings.Join(row, "\t"))
+		errs = errors.Join(errs, err)
+	}
+
+	everyone := []string{"Everyone"}
+	for _, hour := range o.Hours {
+		if hour.Everyone {
+			everyone = append(everyone, "*")
+		} else {
+			everyone = append(everyone, "")
+		}
+	}
+	_, err = fmt.Fprintln(tw, strings.Join(everyone, "\t"))
+	errs = errors.Join(errs, err, tw.Flush())
+
+	_, err = fmt.Fprintln(w)
+	errs = errors.Join(errs, err)
+	if len(o.Slots) == 0 {
+		_, err = fmt.Fprintln(w, o.noSlots())
+		return errors.Join(errs, err)
+	}
+	return errors.Join(errs, writeRows(w, []string{"Epoch", "Start", "End"}, o.slotRows()))
+}
+
+// writePretty highlights working hours, and selects the hours everyone is working.
+func (o *MeetOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+
+	rows := make([][]string, 0, len(o.Locales))
+	for i, locale := range o.Locales {
+		row := []string{locale.Name}
+		for _, hour := range o.Hours {
+			row = append(row, hour.Locales[i].clock())
+		}
+		rows = append(rows, row)
+	}
+
+	grid := table.New().
+		Border(sheet.Table.BorderThickness).
+		BorderStyle(sheet.Table.Border).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if col == 0 {
+				if row == table.HeaderRow {
+					return sheet.Table.Header
+				}
+				return sheet.Table.EvenRow
+			}
+
+			hour := o.Hours[col-1]
+			switch {
+			case row == table.HeaderRow && hour.Everyone:
+				return sheet.Table.Selected
+			case row == table.HeaderRow:
+				return sheet.Table.Header
+			case hour.Everyone:
+				return sheet.Table.Selected
+			case hour.Locales[row].Working:
+				return sheet.Table.Highlight
+			default:
+				return sheet.Table.OddRow
+			}
+		}).
+		Headers(o.headers()...).
+		Rows(rows...)
+
+	var errs error
+	_, err := lipgloss.Fprintln(w, sheet.Keyword.Render("Date:"), sheet.Text.Render(o.summary()))
+	errs = errors.Join(errs, err)
+	_, err = lipgloss.Fprintln(w, grid)
+	errs = errors.Join(errs, err)
+
+	if len(o.Slots) == 0 {
+		_, err = lipgloss.Fprintln(w, sheet.TextSubdued.Render(o.noSlots()))
+		return errors.Join(errs, err)
+	}
+	_, err = lipgloss.Fprintln(w, dataTable(sheet, []string{"Epoch", "Start", "End"}, o.slotRows()))
+	return errors.Join(errs, err)
+}
+
+func (o *MeetOutput) writeJson(w io.Writer) error {
+	return writeJsonLine(w, o, "meet")
+}
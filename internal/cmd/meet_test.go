@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"testing"
+)
+
+// Test_Meet covers basic command functionality and validation.
+func Test_Meet(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "happy path - grid and slots",
+			args: []string{
+				"meet",
+				"--zones",
+				"NYC=America/New_York,London=Europe/London",
+				"--date",
+				"2025-09-29",
+				"--base",
+				"UTC",
+				"--duration",
+				"90m",
+			},
+			expectedOutput: []string{
+				"Date: Monday, September 29, 2025 (UTC)\n",
+				"LOCALE   00 01 02 03 04 05 06 07 08  09  10  11  12  13  14  15  16  17  18  19  20  21 22 23\n",
+				"London   01 02 03 04 05 06 07 08 09* 10* 11* 12* 13* 14* 15* 16* 17  18  19  20  21  22 23 00\n",
+				"NYC      20 21 22 23 00 01 02 03 04  05  06  07  08  09* 10* 11* 12* 13* 14* 15* 16* 17 18 19\n",
+				"EPOCH         START                   END\n" +
+					"1759150800    2025-09-29T13:00:00Z    2025-09-29T14:30:00Z\n" +
+					"1759152600    2025-09-29T13:30:00Z    2025-09-29T15:00:00Z\n" +
+					"1759154400    2025-09-29T14:00:00Z    2025-09-29T15:30:00Z\n" +
+					"1759156200    2025-09-29T14:30:00Z    2025-09-29T16:00:00Z\n",
+			},
+		},
+		{
+			name: "happy path - short meetings start on the half hour",
+			args: []string{
+				"meet",
+				"--zones",
+				"NYC=America/New_York,London=Europe/London",
+				"--date",
+				"2025-09-29",
+				"--base",
+				"UTC",
+				"--duration",
+				"30m",
+			},
+			expectedOutput: []string{
+				"EPOCH         START                   END\n" +
+					"1759150800    2025-09-29T13:00:00Z    2025-09-29T13:30:00Z\n" +
+					"1759152600    2025-09-29T13:30:00Z    2025-09-29T14:00:00Z\n" +
+					"1759154400    2025-09-29T14:00:00Z    2025-09-29T14:30:00Z\n" +
+					"1759156200    2025-09-29T14:30:00Z    2025-09-29T15:00:00Z\n" +
+					"1759158000    2025-09-29T15:00:00Z    2025-09-29T15:30:00Z\n" +
+					"1759159800    2025-09-29T15:30:00Z    2025-09-29T16:00:00Z\n",
+			},
+		},
+		{
+			name: "happy path - hours only partly inside the working day",
+			args: []string{
+				"meet",
+				"-z",
+				"Delhi=Asia/Kolkata,London=Europe/London",
+				"-d",
+				"2025-09-29",
+				"--base",
+				"UTC",
+			},
+			expectedOutput: []string{
+				"Delhi    05:30 06:30 07:30 08:30 09:30* 10:30* 11:30* 12:30* 13:30* 14:30* 15:30* 16:30 17:30 ",
+				"EPOCH         START                   END\n" +
+					"1759132800    2025-09-29T08:00:00Z    2025-09-29T09:00:00Z\n",
+			},
+		},
+		{
+			name: "happy path - json",
+			args: []string{
+				"meet",
+				"-ojson",
+				"-z",
+				"NYC=America/New_York,London=Europe/London",
+				"-d",
+				"2025-09-29",
+				"--base",
+				"UTC",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			expectedOutput: []string{
+				"{\"Date\":\"2025-09-29\",\"Zone\":\"UTC\",\"Duration\":\"1h\",\"Locales\":[{\"Name\":\"London\",\"Zone\":\"Europe/London\",\"Hours\":\"09:00-17:00 Mon-Fri\"},{\"Name\":\"NYC\",\"Zone\":\"America/New_York\",\"Hours\":\"09:00-17:00 Mon-Fri\"}]",
+				"{\"Start\":\"2025-09-29T13:00:00Z\",\"Locales\":[{\"Name\":\"London\",\"Time\":\"2025-09-29T14:00:00+01:00\",\"Working\":true},{\"Name\":\"NYC\",\"Time\":\"2025-09-29T09:00:00-04:00\",\"Working\":true}],\"Everyone\":true}",
+				"\"Slots\":[{\"Epoch\":\"1759150800\",\"Start\":\"2025-09-29T13:00:00Z\",\"End\":\"2025-09-29T14:00:00Z\"},{\"Epoch\":\"1759152600\",\"Start\":\"2025-09-29T13:30:00Z\",\"End\":\"2025-09-29T14:30:00Z\"},{\"Epoch\":\"1759154400\",\"Start\":\"2025-09-29T14:00:00Z\",\"End\":\"2025-09-29T15:00:00Z\"},{\"Epoch\":\"1759156200\",\"Start\":\"2025-09-29T14:30:00Z\",\"End\":\"2025-09-29T15:30:00Z\"},{\"Epoch\":\"1759158000\",\"Start\":\"2025-09-29T15:00:00Z\",\"End\":\"2025-09-29T16:00:00Z\"}],\"Tzdata\":\"" + goTzdata + "\"}\n",
+			},
+		},
+		{
+			name: "happy path - no slots",
+			args: []string{
+				"meet",
+				"-z",
+				"NYC=America/New_York,Tokyo=Asia/Tokyo",
+				"-d",
+				"2025-09-29",
+				"--base",
+				"UTC",
+			},
+			expectedOutput: []string{
+				"No 1h slots when every locale is working\n",
+			},
+		},
+		{
+			name: "happy path - working hours flags",
+			args: []string{
+				"meet",
+				"-ojson",
+				"-z",
+				"NYC=America/New_York,Tokyo=Asia/Tokyo",
+				"-d",
+				"2025-09-29",
+				"--base",
+				"UTC",
+				"--working-hours",
+				"07:00-23:00",
+				"--working-days",
+				"mon-sat",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			expectedOutput: []string{
+				"\"Slots\":[{\"Epoch\":\"1759143600\",\"Start\":\"2025-09-29T11:00:00Z\",\"End\":\"2025-09-29T12:00:00Z\"},{\"Epoch\":\"1759145400\",\"Start\":\"2025-09-29T11:30:00Z\",\"End\":\"2025-09-29T12:30:00Z\"},{\"Epoch\":\"1759147200\",\"Start\":\"2025-09-29T12:00:00Z\",\"End\":\"2025-09-29T13:00:00Z\"},{\"Epoch\":\"1759149000\",\"Start\":\"2025-09-29T12:30:00Z\",\"End\":\"2025-09-29T13:30:00Z\"},{\"Epoch\":\"1759150800\",\"Start\":\"2025-09-29T13:00:00Z\",\"End\":\"2025-09-29T14:00:00Z\"},{\"Epoch\":\"1759183200\",\"Start\":\"2025-09-29T22:00:00Z\",\"End\":\"2025-09-29T23:00:00Z\"},{\"Epoch\":\"1759185000\",\"Start\":\"2025-09-29T22:30:00Z\",\"End\":\"2025-09-29T23:30:00Z\"},{\"Epoch\":\"1759186800\",\"Start\":\"2025-09-29T23:00:00Z\",\"End\":\"2025-09-30T00:00:00Z\"},{\"Epoch\":\"1759188600\",\"Start\":\"2025-09-29T23:30:00Z\",\"End\":\"2025-09-30T00:30:00Z\"}],\"Tzdata\":\"" + goTzdata + "\"}\n",
+			},
+		},
+		{
+			name: "happy path - team working hours",
+			args: []string{
+				"meet",
+				"-ojson",
+				"--team",
+				"gulf",
+				"-d",
+				"2025-09-28",
+				"--base",
+				"Asia/Dubai",
+				"--duration",
+				"8h",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			config: teamsConfig,
+			expectedOutput: []string{
+				"\"Locales\":[{\"Name\":\"Carol\",\"Zone\":\"Asia/Dubai\",\"Hours\":\"12:00-20:00 Sun-Thu\"}]",
+				"\"Slots\":[{\"Epoch\":\"1759046400\",\"Start\":\"2025-09-28T12:00:00+04:00\",\"End\":\"2025-09-28T20:00:00+04:00\"}],\"Tzdata\":\"" + goTzdata + "\"}\n",
+			},
+		},
+		{
+			name: "happy path - relative date",
+			args: []string{
+				"meet",
+				"-z",
+				"UTC=UTC",
+				"--date",
+				"tomorrow",
+				"--base",
+				"UTC",
+			},
+			expectedOutput: []string{
+				"Date: Sunday, January 2, 2000 (UTC)\n",
+			},
+		},
+		{
+			name: "happy path - the day daylight saving time ends has 25 hours",
+			args: []string{
+				"meet",
+				"-z",
+				"NYC=America/New_York",
+				"--date",
+				"2025-11-02",
+				"--base",
+				"America/New_York",
+			},
+			expectedOutput: []string{
+				"LOCALE   00 01 01 02 03 04 05 06 07 08 09 10 11 12 13 14 15 16 17 18 19 20 21 22 23\n",
+			},
+		},
+		{
+			name: "invalid duration",
+			args: []string{
+				"meet",
+				"-z",
+				"UTC=UTC",
+				"--duration",
+				"0s",
+			},
+			expectedError: "invalid duration flag: 0s, must be more than 0 and at most 24h",
+		},
+		{
+			name: "invalid working hours",
+			args: []string{
+				"meet",
+				"-z",
+				"UTC=UTC",
+				"--working-hours",
+				"9-5",
+			},
+			expectedError: "invalid working hours: invalid hours \"9-5\": invalid time of day \"9\"",
+		},
+		{
+			name: "invalid date",
+			args: []string{
+				"meet",
+				"-z",
+				"UTC=UTC",
+				"--date",
+				"someday",
+			},
+			expectedError: "invalid date flag: someday: invalid expression: unexpected \"someday\"",
+		},
+		{
+			name: "invalid base",
+			args: []string{
+				"meet",
+				"--base",
+				"Mars/Olympus_Mons",
+			},
+			expectedError: "invalid base Mars/Olympus_Mons: unknown time zone Mars/Olympus_Mons",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -11,69 +12,114 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/DanStough/epok/datemath"
+	"github.com/DanStough/epok/internal/i18n"
 	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/natural"
+	"github.com/DanStough/epok/parse"
+	"github.com/DanStough/epok/tz"
 )
 
 // newNowCmd creates the now subcommand.
 func newNowCmd() *cobra.Command {
 	nowCmd := &cobra.Command{
-		Use:   "now",
+		Use:   "now [expression]",
 		Short: "create unix timestamp for current instant",
 		Long: `Use the now command to create a unix epoch timestamp for the current instant. 
-The precision can be adjusted using that flag.`,
+The precision can be adjusted using that flag.
+
+An optional expression relative to the current instant, like "3 days ago", "start of month" or
+the date-math "now-1h/d", is resolved with the calendar rules of the timezone flag.`,
 		GroupID: groupIDEpochCommands,
 		Example: `# generate unix timestamp in seconds
 epok now
 
 # generate unix timestamp in nanoseconds
-epok now -p ns`,
+epok now -p ns
+
+# generate unix timestamp for the start of today in Tokyo
+epok now "today" -z Asia/Tokyo
+
+# generate unix timestamp for the start of the previous hour in milliseconds
+epok now "now-1h/h" -p ms
+
+# generate a Windows FILETIME, or a timestamp in an epoch from the config file
+epok now --epoch filetime`,
 
-		Args: cobra.MaximumNArgs(0),
+		Args: cobra.MaximumNArgs(1),
 
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			bindFlags(cmd)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runNow(cmd)
+			return runNow(cmd, args)
 		},
 		SilenceUsage: true,
 	}
 
-	nowCmd.Flags().StringP("precision", "p", "seconds", "precision for unix timestamp. valid units are seconds [s,secs], milliseconds [ms, millis], microseconds [us, micros], and nanoseconds [ns, nanos]")
+	nowCmd.Flags().StringP("precision", "p", "seconds", "precision for unix timestamp. "+precisionUnits)
+	nowCmd.Flags().StringP("timezone", "z", "Local",
+		"timezone for the calendar rules of an expression. Use 'Local' for system time. "+timezoneSpecifiers)
+	nowCmd.Flags().StringP("epoch", "e", parse.EpochUnix,
+		"epoch of the timestamp. The precision only applies to unix timestamps. "+epochNames())
 
 	return nowCmd
 }
 
-type precision string
+func runNow(cmd *cobra.Command, args []string) error {
+	prec, err := getPrecision()
+	if err != nil {
+		return err
+	}
+	if prec == parse.Auto {
+		return fmt.Errorf("invalid precision flag: %s", viper.GetString("precision"))
+	}
 
-const (
-	precisionSeconds      precision = "seconds"
-	precisionMilliseconds precision = "milliseconds"
-	precisionMicroseconds precision = "microseconds"
-	precisionNanoseconds  precision = "nanoseconds"
-)
+	custom, err := customEpochs()
+	if err != nil {
+		return err
+	}
+	epoch, err := getEpoch(custom)
+	if err != nil {
+		return err
+	}
+	if epoch.Name != parse.EpochUnix && cmd.Flags().Changed("precision") {
+		return fmt.Errorf("precision can't be set for %s timestamps", epoch.Name)
+	}
 
-func runNow(cmd *cobra.Command) error {
-	str := viper.GetString("precision")
-	prec := precision(str)
+	lang, err := getLanguage()
+	if err != nil {
+		return err
+	}
 
-	switch prec {
-	// support shorthands
-	case precisionSeconds, "s", "secs":
-		prec = precisionSeconds
-	case precisionMilliseconds, "ms", "millis":
-		prec = precisionMilliseconds
-	case precisionMicroseconds, "us", "micros":
-		prec = precisionMicroseconds
-	case precisionNanoseconds, "ns", "nanos":
-		prec = precisionNanoseconds
-	default:
-		return fmt.Errorf("invalid precision flag: %s", prec)
+	now := time.Now()
+	if len(args) > 0 {
+		timezone := viper.GetString("timezone")
+		loc, err := tz.Resolve(timezone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone %s: %w", timezone, err)
+		}
+
+		if datemath.IsExpression(args[0]) {
+			result, err := datemath.Eval(args[0], datemath.Options{Now: now, Location: loc, Parse: parse.Options{Unit: prec}})
+			if err != nil {
+				return fmt.Errorf("could not evaluate expression: %w", err)
+			}
+			now = result.Time
+		} else {
+			now, err = natural.Parse(args[0], natural.Options{Now: now, Location: loc, LoadZone: tz.Resolve})
+			if err != nil {
+				return fmt.Errorf("could not parse expression: %w", err)
+			}
+		}
 	}
 
 	out := &NowOutput{
-		Now:       time.Now().In(time.UTC),
+		Now:       now.In(time.UTC),
+		Tzdata:    tzdataVersion(),
 		precision: prec,
+		epoch:     epoch,
+		lang:      lang,
 	}
 
 	mode, err := getOutput()
@@ -81,16 +127,7 @@ func runNow(cmd *cobra.Command) error {
 		return err
 	}
 
-	switch mode {
-	case outputModePretty:
-		return out.writePretty(cmd.OutOrStdout())
-	case outputModeSimple:
-		return out.writeSimple(cmd.OutOrStdout())
-	case outputModeJson:
-		return out.writeJson(cmd.OutOrStdout())
-	default:
-		return fmt.Errorf("unexpected output format: %s", mode)
-	}
+	return writeOutput(cmd, mode, out)
 }
 
 var _ json.Marshaler = (*NowOutput)(nil)
@@ -98,9 +135,12 @@ var _ json.Marshaler = (*NowOutput)(nil)
 // NowOutput is the data needed to render the result of the now command.
 // It will serialize the time to variable precision.
 type NowOutput struct {
-	Now time.Time // Now is always UTC time, since it shows up across all JSON outputs.
+	Now    time.Time // Now is always UTC time, since it shows up across all JSON outputs.
+	Tzdata string
 
-	precision precision
+	precision parse.Unit
+	epoch     parse.Epoch
+	lang      i18n.Language
 }
 
 func (o *NowOutput) MarshalJSON() ([]byte, error) {
@@ -109,8 +149,17 @@ func (o *NowOutput) MarshalJSON() ([]byte, error) {
 		return nil, err
 	}
 
-	str := fmt.Sprintf("{ \"Epoch\": \"%s\", \"Now\": \"%s\" }", ts, o.Now.Format(time.RFC3339))
-	return []byte(str), nil
+	return json.Marshal(struct {
+		Epoch    string
+		Encoding string
+		Now      string
+		Tzdata   string `json:",omitempty"`
+	}{
+		Epoch:    ts,
+		Encoding: o.epoch.Name,
+		Now:      o.Now.Format(time.RFC3339),
+		Tzdata:   o.Tzdata,
+	})
 }
 
 func (o *NowOutput) writeSimple(w io.Writer) error {
@@ -131,9 +180,13 @@ func (o *NowOutput) writePretty(w io.Writer) error {
 		return err
 	}
 
+	name := string(o.precision)
+	if o.epoch.Name != parse.EpochUnix {
+		name = o.epoch.Name
+	}
 	caser := cases.Title(language.English)
-	label := fmt.Sprintf("%s Epoch:", caser.String(string(o.precision)))
-	_, err = fmt.Fprintln(w, sheet.Keyword.Render(label), sheet.Text.Render(epoch))
+	label := fmt.Sprintf("%s Epoch:", caser.String(name))
+	_, err = fmt.Fprintln(w, sheet.Keyword.Render(label), sheet.Text.Render(o.lang.Number(epoch)))
 	return err
 }
 
@@ -151,18 +204,37 @@ func (o *NowOutput) writeJson(w io.Writer) error {
 }
 
 func (o *NowOutput) getEpochWithPrecision() (string, error) {
+	if o.epoch.Name != parse.EpochUnix {
+		return o.epoch.EncodeTicks(o.Now), nil
+	}
+	return formatEpoch(o.Now, o.precision)
+}
+
+// formatEpoch prints the unix timestamp of t at the given precision.
+func formatEpoch(t time.Time, prec parse.Unit) (string, error) {
 	var ts string
-	switch o.precision {
-	case precisionSeconds:
-		ts = fmt.Sprintf("%d", o.Now.Unix())
-	case precisionMilliseconds:
-		ts = fmt.Sprintf("%d", o.Now.UnixNano()/int64(time.Millisecond))
-	case precisionMicroseconds:
-		ts = fmt.Sprintf("%d", o.Now.UnixNano()/int64(time.Microsecond))
-	case precisionNanoseconds:
-		ts = fmt.Sprintf("%d", o.Now.UnixNano())
+	switch prec {
+	case parse.Seconds:
+		ts = fmt.Sprintf("%d", t.Unix())
+	case parse.Milliseconds:
+		ts = fmt.Sprintf("%d", t.UnixMilli())
+	case parse.Microseconds:
+		ts = fmt.Sprintf("%d", t.UnixMicro())
+	case parse.Nanoseconds, parse.Picoseconds, parse.Femtoseconds:
+		// Nanoseconds overflow an int64 outside the years 1678 to 2262, and the finer precisions within hours
+		// of 1970, and time.Time has nothing finer than a nanosecond.
+		perNano := int64(1)
+		switch prec {
+		case parse.Picoseconds:
+			perNano = 1_000
+		case parse.Femtoseconds:
+			perNano = 1_000_000
+		}
+		ticks := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(int64(time.Second)))
+		ticks.Add(ticks, big.NewInt(int64(t.Nanosecond())))
+		ts = ticks.Mul(ticks, big.NewInt(perNano)).String()
 	default:
-		return "", fmt.Errorf("unexpected precision: %s", o.precision)
+		return "", fmt.Errorf("unexpected precision: %s", prec)
 	}
 	return ts, nil
 }
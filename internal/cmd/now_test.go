@@ -2,17 +2,11 @@ package cmd
 
 import (
 	"testing"
+	"time"
 )
 
 func Test_Now(t *testing.T) {
-	testCases := []struct {
-		name string
-		args []string
-		in   string
-
-		expectedError  string
-		expectedOutput []string
-	}{
+	testCases := []testCase{
 		{
 			name: "happy path - seconds",
 			args: []string{
@@ -59,18 +53,161 @@ func Test_Now(t *testing.T) {
 			args: []string{
 				"now",
 				"-ojson",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			expectedOutput: []string{
+				"{\"Epoch\":\"946684800\",\"Encoding\":\"unix\",\"Now\":\"2000-01-01T00:00:00Z\",\"Tzdata\":\"" + goTzdata + "\"}\n",
+			},
+		},
+		{
+			name: "invalid precision",
+			args: []string{
+				"now",
+				"-pauto",
+			},
+			expectedError: "invalid precision flag: auto",
+		},
+		{
+			name: "happy path - expression",
+			args: []string{
+				"now",
+				"3 days ago",
 			},
 			expectedOutput: []string{
-				"{\"Epoch\":\"946684800\",\"Now\":\"2000-01-01T00:00:00Z\"}\n",
+				"946425600\n",
+			},
+		},
+		{
+			name: "happy path - expression with timezone",
+			args: []string{
+				"now",
+				"tomorrow noon",
+				"-z",
+				"Asia/Tokyo",
+			},
+			expectedOutput: []string{
+				"946782000\n",
+			},
+		},
+		{
+			name: "happy path - date math",
+			args: []string{
+				"now",
+				"now-1d/d",
+				"-pms",
+				"-z",
+				"UTC",
+			},
+			expectedOutput: []string{
+				"946598400000\n",
+			},
+		},
+		{
+			name: "invalid date math",
+			args: []string{
+				"now",
+				"now/x",
+			},
+			expectedError: "could not evaluate expression: invalid date-math expression: unknown unit \"x\"",
+		},
+		{
+			name: "invalid expression",
+			args: []string{
+				"now",
+				"banana",
+			},
+			expectedError: "could not parse expression: invalid expression: unexpected \"banana\"",
+		},
+		{
+			name: "happy path - built-in epoch",
+			args: []string{
+				"now",
+				"--epoch",
+				"filetime",
+			},
+			expectedOutput: []string{
+				"125911584000000000\n",
+			},
+		},
+		{
+			name: "happy path - config epoch",
+			args: []string{
+				"now",
+				"-ojson",
+				"--epoch",
+				"events",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			config: eventsConfig,
+			expectedOutput: []string{
+				"{\"Epoch\":\"-473385600000\",\"Encoding\":\"events\",\"Now\":\"2000-01-01T00:00:00Z\",\"Tzdata\":\"" + goTzdata + "\"}\n",
+			},
+		},
+		{
+			name: "happy path - built-in epoch between ticks",
+			args: []string{
+				"now",
+				"--epoch",
+				"webkit",
+			},
+			wait: 1234567891 * time.Nanosecond,
+			expectedOutput: []string{
+				"12591158401234567\n",
+			},
+		},
+		{
+			name: "happy path - config epoch between ticks",
+			args: []string{
+				"now",
+				"--epoch",
+				"events",
+			},
+			config: eventsConfig,
+			wait:   1234567891 * time.Nanosecond,
+			expectedOutput: []string{
+				"-473385598766\n",
+			},
+		},
+		{
+			name: "precision with another epoch",
+			args: []string{
+				"now",
+				"-e",
+				"webkit",
+				"-pms",
+			},
+			expectedError: "precision can't be set for webkit timestamps",
+		},
+		{
+			name: "happy path - simple output isn't grouped",
+			args: []string{
+				"now",
+				"--lang",
+				"fr",
+			},
+			expectedOutput: []string{
+				"946684800\n",
+			},
+		},
+		{
+			name: "invalid language",
+			args: []string{
+				"now",
+				"--lang",
+				"xx",
 			},
+			expectedError: "invalid lang flag: unsupported language: xx",
 		},
 		{
-			name: "no arguments allowed",
+			name: "too many arguments",
 			args: []string{
 				"now",
+				"3 days ago",
 				"banana",
 			},
-			expectedError: "accepts at most 0 arg(s), received 1",
+			expectedError: "accepts at most 1 arg(s), received 2",
 		},
 	}
 
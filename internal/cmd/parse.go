@@ -5,22 +5,26 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"sort"
+	"math/big"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
 
 	"github.com/charmbracelet/lipgloss/v2"
-	"github.com/charmbracelet/lipgloss/v2/table"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/DanStough/epok/datemath"
+	"github.com/DanStough/epok/internal/i18n"
 	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/natural"
 	"github.com/DanStough/epok/parse"
 )
 
 const (
-	RFC3339NanoTime = "15:04:05.999999999Z07:00"
+	// TimeOfDayNano is the time column of the locale table. The offset has its own column.
+	TimeOfDayNano = "15:04:05.999999999"
 )
 
 // newParseCmd creates the parse subcommand.
@@ -35,6 +39,18 @@ other formats. It can handle timestamps in various precisions and formats.`,
 		Example: `# fuzzy-parse timestamp
 epok parse 1751074598
 
+# fractional seconds, like the output of Python's time.time()
+epok parse 1751074598.123456
+
+# evaluate date math, keeping the precision of the input
+epok parse 1751074598123+2d/d
+
+# force the precision of a small millisecond value
+epok parse 5000 --precision ms
+
+# decode an Active Directory lastLogonTimestamp
+epok parse 133955481980000000 --epoch ldap
+
 # Read from stdin
 pbpaste | epoch parse
 
@@ -53,14 +69,15 @@ epok parse 1751074598 -z Big\ Ben=Europe/London,Tokyo\ SkyTree=Asia/Tokyo,Empire
 		SilenceUsage: true,
 	}
 
-	defaultLocales := map[string]string{
-		"Local": "Local",
-		"UTC":   "UTC",
-	}
-
-	parseCmd.Flags().StringToStringP("timezone", "z", defaultLocales,
-		"override the map of locales:timezones. "+
-			"Use 'Local' for system time.")
+	addLocalesFlag(parseCmd)
+	addRelativeFlags(parseCmd)
+	addTransitionWindowFlag(parseCmd)
+	parseCmd.Flags().StringP("precision", "p", "auto",
+		"precision of the input timestamp. By default it is inferred from the magnitude. "+precisionUnits)
+	parseCmd.Flags().Bool("strict", false,
+		"reject timestamps when the inferred precision is ambiguous, e.g. values close to 1970")
+	parseCmd.Flags().StringP("epoch", "e", parse.EpochUnix,
+		"epoch of the input timestamp. Other epochs are suggested as alternates when it is unix. "+epochNames())
 	return parseCmd
 }
 
@@ -81,38 +98,158 @@ func runParse(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	timezones := viper.GetStringMapString("timezone")
-	if len(timezones) == 0 {
-		return errors.New("must specify at least one locale timezone")
+	locales, err := getLocales(cmd)
+	if err != nil {
+		return err
+	}
+
+	lang, err := getLanguage()
+	if err != nil {
+		return err
+	}
+
+	relative, err := getRelativeOptions()
+	if err != nil {
+		return err
+	}
+
+	window, err := getTransitionWindow()
+	if err != nil {
+		return err
+	}
+
+	prec, err := getPrecision()
+	if err != nil {
+		return err
+	}
+
+	custom, err := customEpochs()
+	if err != nil {
+		return err
+	}
+	epoch, err := getEpoch(custom)
+	if err != nil {
+		return err
+	}
+	if prec != parse.Auto && epoch.Name != parse.EpochUnix {
+		return fmt.Errorf("precision can't be set for %s timestamps", epoch.Name)
 	}
 
-	locales := make(map[string]*time.Location, len(timezones))
-	for name, timezone := range timezones {
-		loc, err := time.LoadLocation(timezone)
+	opts := parse.Options{
+		Unit:   prec,
+		Strict: viper.GetBool("strict"),
+		Epoch:  epoch,
+		Epochs: custom,
+	}
+
+	// The relative time is measured from the same instant as the "now" anchor.
+	now := time.Now()
+
+	input = strings.TrimSpace(input)
+	encoded, expression := input, ""
+	var result parse.Result
+	if datemath.IsExpression(input) {
+		result, err = datemath.Eval(input, datemath.Options{Now: now, Parse: opts})
 		if err != nil {
-			return fmt.Errorf("invalid timezone %s for locale %s: %w", timezone, name, err)
+			return fmt.Errorf("could not evaluate expression: %w", err)
 		}
-		locales[name] = loc
+
+		// Print the result at the same precision and in the same epoch as the input.
+		expression = input
+		if digits := fractionDigits(result.Value); epoch.Name == parse.EpochUnix && digits > 0 {
+			encoded = formatFraction(result.Time, result.Unit, result.Subnanoseconds, digits)
+		} else if epoch.Name == parse.EpochUnix {
+			encoded, err = formatEpoch(result.Time, result.Unit)
+			if err != nil {
+				return err
+			}
+			encoded = addSubnanoseconds(encoded, result.Unit, result.Subnanoseconds)
+		} else {
+			encoded = epoch.Encode(result.Time)
+		}
+	} else {
+		result, err = parse.Analyze(input, opts)
+		if err != nil {
+			return fmt.Errorf("could not parse input: %w", err)
+		}
+		encoded = result.Value
 	}
 
-	input = strings.TrimSpace(input)
-	timestamp, err := parse.String(input)
-	if err != nil {
-		return fmt.Errorf("could not parse input: %w", err)
+	out := newParseOutput(encoded, result, now, locales, relative, lang)
+	out.Expression = expression
+	out.Warnings = transitionWarnings(out.Locales, window)
+
+	return writeOutput(cmd, mode, out)
+}
+
+// fractionDigits counts the digits after the decimal point of a decimal epoch, like 1 for "1751074598.5"
+// or 2 for "1.75107459850e9". It's 0 for integers.
+func fractionDigits(value string) int {
+	mantissa, exponent := value, 0
+	if i := strings.IndexAny(value, "eE"); i >= 0 {
+		mantissa = value[:i]
+		exponent, _ = strconv.Atoi(value[i+1:])
 	}
+	_, fraction, _ := strings.Cut(mantissa, ".")
+	return max(len(fraction)-exponent, 0)
+}
 
-	out := newParseOutput(input, timestamp, locales)
+// unitNanos are the nanoseconds in each precision, as a fraction for the ones finer than a nanosecond.
+var unitNanos = map[parse.Unit]*big.Rat{
+	parse.Seconds:      big.NewRat(int64(time.Second), 1),
+	parse.Milliseconds: big.NewRat(int64(time.Millisecond), 1),
+	parse.Microseconds: big.NewRat(int64(time.Microsecond), 1),
+	parse.Nanoseconds:  big.NewRat(1, 1),
+	parse.Picoseconds:  big.NewRat(1, 1_000),
+	parse.Femtoseconds: big.NewRat(1, 1_000_000),
+}
 
-	switch mode {
-	case outputModePretty:
-		return out.writePretty(cmd.OutOrStdout())
-	case outputModeSimple:
-		return out.writeSimple(cmd.OutOrStdout())
-	case outputModeJson:
-		return out.writeJson(cmd.OutOrStdout())
-	default:
-		return fmt.Errorf("unexpected output format: %s", mode)
+// formatFraction writes an epoch with digits after the decimal point, like a decimal input. The digits
+// past the last one are truncated toward the past, like Time.
+func formatFraction(t time.Time, unit parse.Unit, subnanos string, digits int) string {
+	nanos := new(big.Rat).SetInt(new(big.Int).Add(
+		new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(int64(time.Second))),
+		big.NewInt(int64(t.Nanosecond())),
+	))
+	if subnanos != "" {
+		extra, _ := new(big.Int).SetString(subnanos, 10)
+		nanos.Add(nanos, new(big.Rat).SetFrac(extra, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(subnanos))), nil)))
 	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	value := new(big.Rat).Quo(nanos, unitNanos[unit])
+	value.Mul(value, new(big.Rat).SetInt(scale))
+	ticks := new(big.Int).Div(value.Num(), value.Denom()) // Div rounds toward negative infinity.
+
+	sign := ""
+	if ticks.Sign() < 0 {
+		sign = "-"
+		ticks.Neg(ticks)
+	}
+	whole, fraction := new(big.Int).QuoRem(ticks, scale, new(big.Int))
+	return fmt.Sprintf("%s%d.%0*d", sign, whole, digits, fraction)
+}
+
+// addSubnanoseconds adds the digits finer than a nanosecond back to a picosecond or femtosecond epoch.
+func addSubnanoseconds(encoded string, unit parse.Unit, subnanos string) string {
+	digits := 0
+	switch unit {
+	case parse.Picoseconds:
+		digits = 3
+	case parse.Femtoseconds:
+		digits = 6
+	}
+	if digits == 0 || subnanos == "" {
+		return encoded
+	}
+
+	ticks, ok := new(big.Int).SetString(encoded, 10)
+	if !ok {
+		return encoded
+	}
+	subnanos = (subnanos + strings.Repeat("0", digits))[:digits]
+	extra, _ := new(big.Int).SetString(subnanos, 10)
+	return ticks.Add(ticks, extra).String()
 }
 
 func readFromStdin(cmd *cobra.Command) (string, error) {
@@ -152,39 +289,61 @@ func readFromStdin(cmd *cobra.Command) (string, error) {
 }
 
 type parseOutput struct {
-	Epoch   string
-	Locales []Locale
+	Epoch      string
+	Expression string `json:",omitempty"` // Expression is the date-math input that was evaluated to Epoch.
+	Locales    []Locale
+
+	// Encoding, Precision and Confidence describe how the epoch was read. Precision is only set for
+	// Unix timestamps.
+	Encoding   string
+	Precision  parse.Unit `json:",omitempty"`
+	Confidence parse.Confidence
+	Alternates []Alternate
+
+	// Subnanoseconds are the digits of the epoch finer than a nanosecond, which follow those of each
+	// locale time.
+	Subnanoseconds string `json:",omitempty"`
+
+	// Warnings are about locales near a daylight saving transition, or whose wall-clock time is ambiguous.
+	Warnings []string `json:",omitempty"`
 
 	// Derived
-	Now time.Time // Now is always UTC time, since it shows up across all JSON outputs.
+	Now      time.Time // Now is always UTC time, since it shows up across all JSON outputs.
+	Relative Relative
+	Tzdata   string `json:",omitempty"`
 
-	relative time.Duration // TODO: make this public so it can be rendered in JSON once we decide on a format
+	lang i18n.Language
 }
 
-type Locale struct {
-	Name string
-	Time time.Time
+// Alternate is another plausible reading of the epoch at a different precision, or in a different epoch.
+type Alternate struct {
+	Encoding  string
+	Precision parse.Unit `json:",omitempty"`
+	Time      time.Time  // Time is always UTC.
 }
 
-func newParseOutput(input string, localTime time.Time, localesByTz map[string]*time.Location) *parseOutput {
-	now := time.Now().In(time.UTC)
+func newParseOutput(input string, result parse.Result, now time.Time, localesByTz map[string]localeZone, relative natural.HumanizeOptions, lang i18n.Language) *parseOutput {
+	now = now.In(time.UTC)
+	localTime := result.Time
 
-	localesByTime := make([]Locale, 0, len(localesByTz))
-	for name, loc := range localesByTz {
-		l := Locale{
-			Name: name,
-			Time: localTime.In(loc),
-		}
-		localesByTime = append(localesByTime, l)
+	alternates := make([]Alternate, 0, len(result.Alternates))
+	for _, alt := range result.Alternates {
+		alternates = append(alternates, Alternate{Encoding: alt.Epoch, Precision: alt.Unit, Time: alt.Time.In(time.UTC)})
 	}
-	sort.Slice(localesByTime, func(i, j int) bool { return localesByTime[i].Name < localesByTime[j].Name })
 
 	return &parseOutput{
-		Epoch:   input,
-		Now:     now,
-		Locales: localesByTime,
-
-		relative: now.Sub(localTime),
+		Epoch:          input,
+		Now:            now,
+		Locales:        withSubnanoseconds(newLocales(localTime, localesByTz), result.Subnanoseconds),
+		Encoding:       result.Epoch,
+		Precision:      result.Unit,
+		Confidence:     result.Confidence,
+		Alternates:     alternates,
+		Subnanoseconds: result.Subnanoseconds,
+		Relative:       newRelative(localTime, now, relative, lang),
+		Tzdata:         tzdataVersion(),
+
+		lang: lang,
 	}
 }
 
@@ -192,73 +351,76 @@ func (o *parseOutput) writeSimple(w io.Writer) error {
 	var errs error
 
 	tw := tabwriter.NewWriter(w, 0, 0, 4, ' ', tabwriter.TabIndent)
-	_, err := fmt.Fprintf(tw, "%s\t%s\t%s\n", "LOCALE", "DATE", "TIME")
+	err := writeLocaleRows(tw, o.Locales, o.lang)
 	errs = errors.Join(errs, err)
 
-	for _, locale := range o.Locales {
-		_, err = fmt.Fprintf(tw, "%s\t%s\t%s\n", locale.Name, formatDate(locale.Time), locale.Time.Format(RFC3339NanoTime))
+	_, err = fmt.Fprintln(tw)
+	errs = errors.Join(errs, err)
+
+	if o.Expression != "" {
+		_, err = fmt.Fprintf(tw, "Epoch: %s\n", o.Epoch)
 		errs = errors.Join(errs, err)
 	}
 
-	duration, label := formatLocalDiff(o.relative)
-	_, err = fmt.Fprintf(tw, "\nRelative: %s %s\n", duration, label)
+	_, err = fmt.Fprintf(tw, "Relative: %s\n", o.Relative.Humanized)
+	errs = errors.Join(errs, err)
+
+	if o.Encoding == parse.EpochUnix {
+		_, err = fmt.Fprintf(tw, "Precision: %s (%s confidence)\n", o.Precision, o.Confidence)
+	} else {
+		_, err = fmt.Fprintf(tw, "Encoding: %s (%s confidence)\n", o.Encoding, o.Confidence)
+	}
 	errs = errors.Join(errs, err)
 
+	if len(o.Alternates) > 0 {
+		_, err = fmt.Fprintf(tw, "Alternates: %s\n", formatAlternates(o.Alternates))
+		errs = errors.Join(errs, err)
+	}
+
+	for _, warning := range o.Warnings {
+		_, err = fmt.Fprintf(tw, "Warning: %s\n", warning)
+		errs = errors.Join(errs, err)
+	}
+
 	return errs
 }
 
 func (o *parseOutput) writePretty(w io.Writer) error {
 	sheet := styles.NewEpokTheme().Sheet()
 
-	rows := make([][]string, 0, len(o.Locales))
-	for _, locale := range o.Locales {
-		rows = append(rows, []string{locale.Name, formatDate(locale.Time), locale.Time.Format(RFC3339NanoTime)})
-	}
+	t := localeTable(sheet, o.Locales, o.lang)
 
-	t := table.New().
-		Border(sheet.Table.BorderThickness).
-		BorderStyle(sheet.Table.Border).
-		StyleFunc(func(row, col int) lipgloss.Style {
-			var style lipgloss.Style
+	var errs error
+	_, err := lipgloss.Fprintln(w, t)
+	errs = errors.Join(errs, err)
 
-			switch {
-			case row == table.HeaderRow:
-				return sheet.Table.Header
-			case row%2 == 0:
-				style = sheet.Table.EvenRow
-			default:
-				style = sheet.Table.OddRow
-			}
+	if o.Expression != "" {
+		_, err = fmt.Fprintln(w, sheet.Keyword.Render("Epoch:"), sheet.Text.Render(o.lang.Number(o.Epoch)))
+		errs = errors.Join(errs, err)
+	}
 
-			localeWidth := 8
-			for _, locale := range o.Locales {
-				if len(locale.Name) > localeWidth {
-					localeWidth = len(locale.Name)
-				}
-			}
+	_, err = fmt.Fprintln(w, sheet.Keyword.Render("Relative:"), o.Relative.render(sheet))
+	errs = errors.Join(errs, err)
 
-			switch col {
-			case 0:
-				style = style.Width(localeWidth + 2) // include padding
-			case 1:
-				style = style.Width(32)
-			case 2:
-				style = style.Width(28)
-			}
+	label, value := "Precision:", string(o.Precision)
+	if o.Encoding != parse.EpochUnix {
+		label, value = "Encoding:", o.Encoding
+	}
+	confidence := fmt.Sprintf("(%s confidence)", o.Confidence)
+	_, err = fmt.Fprintln(w, sheet.Keyword.Render(label), sheet.Text.Render(value), sheet.TextSubdued.Italic(true).Render(confidence))
+	errs = errors.Join(errs, err)
 
-			return style
-		}).
-		// TODO (dans): timezone could be a separate field
-		Headers("Locale", "Date", "Time").
-		Rows(rows...)
+	if len(o.Alternates) > 0 {
+		_, err = fmt.Fprintln(w, sheet.Keyword.Render("Alternates:"), sheet.TextSubdued.Render(formatAlternates(o.Alternates)))
+		errs = errors.Join(errs, err)
+	}
 
-	var errs error
-	_, err := lipgloss.Fprintln(w, t)
-	errs = errors.Join(errs, err)
+	for _, warning := range o.Warnings {
+		_, err = fmt.Fprintln(w, sheet.Keyword.Render("Warning:"), sheet.Text.Render(warning))
+		errs = errors.Join(errs, err)
+	}
 
-	duration, label := formatLocalDiff(o.relative)
-	_, err = fmt.Fprintln(w, sheet.Keyword.Render("Relative:"), sheet.Text.Render(duration), sheet.TextSubdued.Italic(true).Render(label))
-	return errors.Join(errs, err)
+	return errs
 }
 
 func (o *parseOutput) writeJson(w io.Writer) error {
@@ -273,18 +435,15 @@ func (o *parseOutput) writeJson(w io.Writer) error {
 	return nil
 }
 
-func formatDate(t time.Time) string {
-	return fmt.Sprintf("%s, %s %d, %d", t.Weekday(), t.Month(), t.Day(), t.Year())
-}
-
-// TODO: break this down by year and day. The highest unit is currently hour.
-// This will need to take into consideration leap days and seconds.
-func formatLocalDiff(diff time.Duration) (string, string) {
-	label := "ago"
-	if diff < 0 {
-		diff = diff.Abs()
-		label = "from now"
+// formatAlternates summarizes the other readings of an epoch, e.g. "as ms: 1970-01-21, as filetime: 2025-06-28".
+func formatAlternates(alternates []Alternate) string {
+	readings := make([]string, 0, len(alternates))
+	for _, alt := range alternates {
+		name := alt.Precision.Short()
+		if alt.Encoding != parse.EpochUnix {
+			name = alt.Encoding
+		}
+		readings = append(readings, fmt.Sprintf("as %s: %s", name, alt.Time.Format(time.DateOnly)))
 	}
-
-	return diff.String(), label
+	return strings.Join(readings, ", ")
 }
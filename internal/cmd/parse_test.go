@@ -14,20 +14,31 @@ import (
 const (
 
 	// This is 1751770507 relative to a "now" of 2000-01-01 0:00
-	afterOutput = `LOCALE    DATE                      TIME
-Local     Saturday, July 5, 2025    22:55:07-04:00
-UTC       Sunday, July 6, 2025      02:55:07Z
+	afterOutput = `LOCALE    DATE                      TIME        OFFSET    ZONE    DST
+Local     Saturday, July 5, 2025    22:55:07    -04:00    EDT     yes
+UTC       Sunday, July 6, 2025      02:55:07    +00:00    UTC     no
 
-Relative: 223634h55m7s from now`
+Relative: 25 years, 6 months from now
+Precision: seconds (high confidence)
+Alternates: as cocoa: 2056-07-06`
 
 	// This is 946080000 relative to a "now" of 2000-01-01 0:00
-	beforeOutput = `LOCALE    DATE                           TIME
-Local     Friday, December 24, 1999      19:00:00-05:00
-UTC       Saturday, December 25, 1999    00:00:00Z
+	beforeOutput = `LOCALE    DATE                           TIME        OFFSET    ZONE    DST
+Local     Friday, December 24, 1999      19:00:00    -05:00    EST     no
+UTC       Saturday, December 25, 1999    00:00:00    +00:00    UTC     no
 
-Relative: 168h0m0s ago`
+Relative: 7 days ago
+Precision: seconds (high confidence)
+Alternates: as cocoa: 2030-12-25`
 )
 
+// eventsConfig defines an epoch that counts milliseconds since 2015.
+const eventsConfig = `epochs:
+  events:
+    origin: 2015-01-01T00:00:00Z
+    unit: ms
+`
+
 // Test_Parse covers basic command functionality and validation.
 func Test_Parse(t *testing.T) {
 	testCases := []testCase{
@@ -74,11 +85,489 @@ func Test_Parse(t *testing.T) {
 				"-ojson",
 				"-z", // We need to force an exact timezone for "local" to work across machines with different settings.
 				"Local=America/New_York,UTC=UTC",
+				"--zoneinfo",
+				goZoneinfo,
 			},
 			in: "1751770507\n",
 			expectedOutput: []string{
-				"{\"Epoch\":\"1751770507\",\"Locales\":[{\"Name\":\"Local\",\"Time\":\"2025-07-05T22:55:07-04:00\"},{\"Name\":\"UTC\",\"Time\":\"2025-07-06T02:55:07Z\"}],\"Now\":\"2000-01-01T00:00:00Z\"}",
+				"{\"Epoch\":\"1751770507\",\"Locales\":[{\"Name\":\"Local\",\"Time\":\"2025-07-05T22:55:07-04:00\",\"Offset\":\"-04:00\",\"Abbreviation\":\"EDT\",\"DST\":true},{\"Name\":\"UTC\",\"Time\":\"2025-07-06T02:55:07Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}]," +
+					"\"Encoding\":\"unix\",\"Precision\":\"seconds\",\"Confidence\":\"high\",\"Alternates\":[" +
+					"{\"Encoding\":\"cocoa\",\"Time\":\"2056-07-06T02:55:07Z\"}]," +
+					"\"Now\":\"2000-01-01T00:00:00Z\",\"Relative\":{\"Duration\":\"P25Y6M5DT2H55M7S\",\"Humanized\":\"25 years, 6 months from now\"},\"Tzdata\":\"" + goTzdata + "\"}",
+			},
+		},
+		{
+			name: "happy path - fractional seconds",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"1751770507.123456789",
+			},
+			expectedOutput: []string{
+				"{\"Epoch\":\"1751770507.123456789\",\"Locales\":[{\"Name\":\"UTC\",\"Time\":\"2025-07-06T02:55:07.123456789Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}]",
+			},
+		},
+		{
+			name: "happy path - digit separators",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"1,751,770,507",
+			},
+			expectedOutput: []string{
+				"{\"Epoch\":\"1751770507\",\"Locales\":[{\"Name\":\"UTC\",\"Time\":\"2025-07-06T02:55:07Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}]",
+				"\"Precision\":\"seconds\",\"Confidence\":\"high\"",
+			},
+		},
+		{
+			name: "happy path - hex",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"+0x68c9e48b",
+			},
+			expectedOutput: []string{
+				"{\"Epoch\":\"1758061707\",\"Locales\":[{\"Name\":\"UTC\",\"Time\":\"2025-09-16T22:28:27Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}]",
+			},
+		},
+		{
+			name: "happy path - scientific notation",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"1.751770507e9",
+			},
+			expectedOutput: []string{
+				"{\"Name\":\"UTC\",\"Time\":\"2025-07-06T02:55:07Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}",
+			},
+		},
+		{
+			name: "happy path - picoseconds keep sub-nanosecond digits",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"1751770507123456789012",
+			},
+			expectedOutput: []string{
+				"UTC       Sunday, July 6, 2025    02:55:07.123456789012    +00:00    UTC     no",
+				"Precision: picoseconds (high confidence)",
+			},
+		},
+		{
+			name: "happy path - femtoseconds JSON",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"1751770507123456789012340",
+			},
+			expectedOutput: []string{
+				"{\"Name\":\"UTC\",\"Time\":\"2025-07-06T02:55:07.123456789Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}",
+				"\"Precision\":\"femtoseconds\",\"Confidence\":\"high\",\"Alternates\":[],\"Subnanoseconds\":\"01234\"",
+			},
+		},
+		{
+			name: "femtoseconds past the year 9999 JSON",
+			args: []string{
+				"parse",
+				"-ojson",
+				"99999999999999999999999999999",
+			},
+			expectedError: "could not parse input: overflow",
+		},
+		{
+			name: "negative nanoseconds before the year 0 JSON",
+			args: []string{
+				"parse",
+				"-ojson",
+				"--",
+				"-99999999999999999999",
+			},
+			expectedError: "could not parse input: overflow",
+		},
+		{
+			name: "happy path - date math in picoseconds",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"1751770507123456789012+1s",
+			},
+			expectedOutput: []string{
+				"{\"Epoch\":\"1751770508123456789012\",\"Expression\":\"1751770507123456789012+1s\"",
+			},
+		},
+		{
+			name: "happy path - forced precision",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"--precision",
+				"ms",
+				"5000",
+			},
+			expectedOutput: []string{
+				"{\"Name\":\"UTC\",\"Time\":\"1970-01-01T00:00:05Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}",
+				"\"Precision\":\"milliseconds\",\"Confidence\":\"exact\"",
+			},
+		},
+		{
+			name: "happy path - precision suffix",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"5000ms",
+			},
+			expectedOutput: []string{
+				"{\"Name\":\"UTC\",\"Time\":\"1970-01-01T00:00:05Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}",
+			},
+		},
+		{
+			name: "happy path - date math keeps the precision",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"1751770507123+2d/d",
+			},
+			expectedOutput: []string{
+				"{\"Epoch\":\"1751932800000\",\"Expression\":\"1751770507123+2d/d\",\"Locales\":[{\"Name\":\"UTC\",\"Time\":\"2025-07-08T00:00:00Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}]",
+			},
+		},
+		{
+			name: "happy path - date math keeps the digits of a decimal",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"1751074598.50+1s",
+			},
+			expectedOutput: []string{
+				"{\"Epoch\":\"1751074599.50\",\"Expression\":\"1751074598.50+1s\",\"Locales\":[{\"Name\":\"UTC\",\"Time\":\"2025-06-28T01:36:39.5Z\"",
+			},
+		},
+		{
+			name: "happy path - date math relative to now",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"now-1h",
+			},
+			expectedOutput: []string{
+				"UTC       Friday, December 31, 1999    23:00:00    +00:00    UTC     no",
+				"Epoch: 946681200\nRelative: 1 hour ago",
+			},
+		},
+		{
+			name: "happy path - more relative units",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"--relative-units",
+				"4",
+				"1751770507",
+			},
+			expectedOutput: []string{
+				"Relative: 25 years, 6 months, 5 days, 2 hours from now",
+			},
+		},
+		{
+			name: "happy path - rounded relative time",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"--relative-units",
+				"1",
+				"--relative-round",
+				"1751770507",
+			},
+			expectedOutput: []string{
+				"Relative: 26 years from now",
+			},
+		},
+		{
+			name: "happy path - localized",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"--lang",
+				"de",
+				"1751770507",
+			},
+			expectedOutput: []string{
+				"ORT    DATUM                    UHRZEIT     VERSATZ    ZONE    SOMMERZEIT\nUTC    Sonntag, 6. Juli 2025    02:55:07    +00:00     UTC     nein",
+				"Relative: in 25 Jahren, 6 Monaten",
+			},
+		},
+		{
+			name: "happy path - language from config",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"1751770507",
+			},
+			config: "lang: es\n",
+			expectedOutput: []string{
+				"\"Humanized\":\"dentro de 25 años, 6 meses\"",
+			},
+		},
+		{
+			name: "happy path - windows filetime",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"--epoch",
+				"ldap",
+				"133955481980000000",
+			},
+			expectedOutput: []string{
+				"UTC       Saturday, June 28, 2025    01:36:38    +00:00    UTC     no",
+				"Encoding: filetime (exact confidence)",
+			},
+		},
+		{
+			name: "happy path - gps week and time of week",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"--epoch",
+				"gps",
+				"2372:524216",
+			},
+			expectedOutput: []string{
+				"\"Locales\":[{\"Name\":\"UTC\",\"Time\":\"2025-06-28T01:36:38Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}],\"Encoding\":\"gps\"",
+			},
+		},
+		{
+			name: "happy path - ntp date math",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"--epoch",
+				"ntp",
+				"ec09c5a6.80000000+1h",
+			},
+			expectedOutput: []string{
+				"UTC       Saturday, June 28, 2025    02:36:38.5    +00:00    UTC     no",
+				"Epoch: ec09d3b6.80000000",
+				"Encoding: ntp (exact confidence)",
+			},
+		},
+		{
+			name: "happy path - chrome date math",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"-e",
+				"chrome",
+				"13395548198000000+1d/d",
+			},
+			expectedOutput: []string{
+				"{\"Epoch\":\"13395628800000000\",\"Expression\":\"13395548198000000+1d/d\"," +
+					"\"Locales\":[{\"Name\":\"UTC\",\"Time\":\"2025-06-29T00:00:00Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}],\"Encoding\":\"webkit\",\"Confidence\":\"exact\"",
+			},
+		},
+		{
+			name: "happy path - other epochs are candidates",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"133955481980000000",
+			},
+			expectedOutput: []string{
+				"Precision: nanoseconds (high confidence)\nAlternates: as filetime: 2025-06-28",
+			},
+		},
+		{
+			name: "happy path - excel serial date",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"--epoch",
+				"excel",
+				"45836.75",
+			},
+			expectedOutput: []string{
+				"UTC       Saturday, June 28, 2025    18:00:00    +00:00    UTC     no",
+				"Encoding: excel (exact confidence)",
+			},
+		},
+		{
+			name: "happy path - serial dates are candidates",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"45836",
+			},
+			expectedOutput: []string{
+				"as excel: 2025-06-28, as excel1904: 2029-06-29, as ole: 2025-06-28, as mjd: 1984-05-16",
+			},
+		},
+		{
+			name: "excel leap day",
+			args: []string{
+				"parse",
+				"--epoch",
+				"excel",
+				"60",
+			},
+			expectedError: "could not parse input: invalid timestamp format: Excel serial 60 is February 29, 1900, which doesn't exist",
+		},
+		{
+			name: "happy path - config epoch",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-z",
+				"UTC=UTC",
+				"--epoch",
+				"events",
+				"330483398000",
+			},
+			config: eventsConfig,
+			expectedOutput: []string{
+				"\"Locales\":[{\"Name\":\"UTC\",\"Time\":\"2025-06-22T00:56:38Z\",\"Offset\":\"+00:00\",\"Abbreviation\":\"UTC\",\"DST\":false}],\"Encoding\":\"events\",\"Confidence\":\"exact\"",
+			},
+		},
+		{
+			name: "happy path - config epochs are candidates",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"330483398000",
+			},
+			config: eventsConfig,
+			expectedOutput: []string{
+				"as events: 2025-06-22",
+			},
+		},
+		{
+			name: "invalid config epoch",
+			args: []string{
+				"parse",
+				"5000",
 			},
+			config:        "epochs:\n  events:\n    origin: 2015-01-01\n    unit: fortnights\n",
+			expectedError: "invalid epoch events in config file: invalid unit \"fortnights\"",
+		},
+		{
+			name: "config epoch shadows a built-in epoch",
+			args: []string{
+				"parse",
+				"5000",
+			},
+			config:        "epochs:\n  chrome:\n    origin: 2015-01-01\n    unit: ms\n",
+			expectedError: "invalid epoch chrome in config file: the name is already used by a built-in epoch",
+		},
+		{
+			name: "precision with another epoch",
+			args: []string{
+				"parse",
+				"-p",
+				"ms",
+				"-e",
+				"webkit",
+				"13395548198000000",
+			},
+			expectedError: "precision can't be set for webkit timestamps",
+		},
+		{
+			name: "invalid epoch",
+			args: []string{
+				"parse",
+				"-e",
+				"mayan",
+				"5000",
+			},
+			expectedError: "invalid epoch flag: mayan",
+		},
+		{
+			name: "invalid date math",
+			args: []string{
+				"parse",
+				"now+1x",
+			},
+			expectedError: "could not evaluate expression: invalid date-math expression: unknown unit \"x\"",
+		},
+		{
+			name: "invalid date math after an epoch",
+			args: []string{
+				"parse",
+				"1751074598+1fortnight",
+			},
+			expectedError: "could not evaluate expression: invalid date-math expression: unknown unit \"fortnight\"",
+		},
+		{
+			name: "strict mode rejects ambiguous precision",
+			args: []string{
+				"parse",
+				"--strict",
+				"5000",
+			},
+			expectedError: "could not parse input: ambiguous precision",
+		},
+		{
+			name: "invalid precision",
+			args: []string{
+				"parse",
+				"-p",
+				"fortnights",
+				"5000",
+			},
+			expectedError: "invalid precision flag: fortnights",
+		},
+		{
+			name: "invalid language",
+			args: []string{
+				"parse",
+				"--lang",
+				"klingon",
+				"5000",
+			},
+			expectedError: "invalid lang flag: unsupported language: klingon",
+		},
+		{
+			name: "invalid relative units",
+			args: []string{
+				"parse",
+				"--relative-units",
+				"0",
+				"5000",
+			},
+			expectedError: "invalid relative-units flag: 0, must be at least 1",
 		},
 		{
 			name: "invalid argument",
@@ -97,6 +586,82 @@ func Test_Parse(t *testing.T) {
 			},
 			expectedError: "accepts at most 1 arg(s), received 2",
 		},
+		{
+			name: "happy path - daylight saving warning",
+			args: []string{
+				"parse",
+				"954655200",
+				"-z",
+				"NY=America/New_York",
+			},
+			expectedOutput: []string{
+				"NY        Sunday, April 2, 2000    01:00:00    -05:00    EST     no",
+				"Warning: NY: 1h before daylight saving time starts at 2000-04-02T07:00:00Z (EST -05:00 → EDT -04:00), " +
+					"wall times from 02:00 to 03:00 are skipped",
+			},
+		},
+		{
+			name: "happy path - ambiguous wall time",
+			args: []string{
+				"parse",
+				"972795600",
+				"-z",
+				"NY=America/New_York",
+				"--output",
+				"json",
+			},
+			expectedOutput: []string{
+				`"Warnings":["NY: 01:00:00 happens twice, as EDT and EST, so it's ambiguous without the offset"]`,
+			},
+		},
+		{
+			name: "invalid transition window",
+			args: []string{
+				"parse",
+				"954655200",
+				"--transition-window",
+				"-1h",
+			},
+			expectedError: "invalid transition-window flag: -1h0m0s, must not be negative",
+		},
+		{
+			name: "happy path - flexible timezones",
+			args: []string{
+				"parse",
+				"1751770507",
+				"-z",
+				"Office=+05:30,Home=PT,Mumbai=mumbai",
+			},
+			expectedOutput: []string{
+				"LOCALE    DATE                      TIME        OFFSET    ZONE         DST\n" +
+					"Home      Saturday, July 5, 2025    19:55:07    -07:00    PDT          yes\n" +
+					"Mumbai    Sunday, July 6, 2025      08:25:07    +05:30    IST          no\n" +
+					"Office    Sunday, July 6, 2025      08:25:07    +05:30    UTC+05:30    no\n",
+			},
+		},
+		{
+			name: "happy path - POSIX timezone",
+			args: []string{
+				"parse",
+				"1751770507",
+				"-z",
+				`"NY=EST5EDT,M3.2.0,M11.1.0"`,
+			},
+			expectedOutput: []string{
+				"NY        Saturday, July 5, 2025    22:55:07    -04:00    EDT     yes\n",
+			},
+		},
+		{
+			name: "ambiguous timezone",
+			args: []string{
+				"parse",
+				"1751770507",
+				"-z",
+				"Office=San Jose",
+			},
+			expectedError: "invalid timezone San Jose for locale Office: ambiguous time zone San Jose, " +
+				"could be San Jose, US (America/Los_Angeles), San Jose, CR (America/Costa_Rica)",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/DanStough/epok/internal/i18n"
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/natural"
+)
+
+// Relative is how far a time is from now.
+type Relative struct {
+	// Duration is the calendar difference from now as an ISO 8601 duration, like "-P6Y3M12DT4H". It is
+	// negative for times in the past.
+	Duration string
+
+	// Humanized describes the difference in words, like "6 years, 3 months ago", in the language of the
+	// output.
+	Humanized string
+
+	amount string // amount is the part of Humanized without the direction, like "6 years, 3 months".
+}
+
+// addRelativeFlags adds the flags that control how relative times are humanized.
+func addRelativeFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("relative-units", 2,
+		"number of units in the relative time, e.g. 2 for \"6 years, 3 months ago\"")
+	cmd.Flags().Bool("relative-round", false,
+		"round the relative time to the nearest last unit, instead of truncating it")
+}
+
+// getRelativeOptions reads the relative flags.
+func getRelativeOptions() (natural.HumanizeOptions, error) {
+	units := viper.GetInt("relative_units")
+	if units < 1 {
+		return natural.HumanizeOptions{}, fmt.Errorf("invalid relative-units flag: %d, must be at least 1", units)
+	}
+	return natural.HumanizeOptions{
+		Units: units,
+		Round: viper.GetBool("relative_round"),
+	}, nil
+}
+
+// newRelative describes t relative to now in a language. The calendar units are counted in UTC, since the
+// locales can each be in a different zone.
+func newRelative(t, now time.Time, opts natural.HumanizeOptions, lang i18n.Language) Relative {
+	span := natural.Approximate(t, now, opts)
+	return Relative{
+		Duration:  natural.Between(now, t, time.UTC).ISO8601(),
+		Humanized: lang.Relative(span),
+		amount:    lang.Amount(span),
+	}
+}
+
+// render styles the relative time for pretty output, with the words around the amount, like "ago",
+// subdued.
+func (r Relative) render(sheet *styles.Sheet) string {
+	before, after, found := strings.Cut(r.Humanized, r.amount)
+	if r.amount == "" || !found {
+		return sheet.Text.Render(r.Humanized)
+	}
+
+	subdued := sheet.TextSubdued.Italic(true)
+	var sb strings.Builder
+	if before != "" {
+		sb.WriteString(subdued.Render(before))
+	}
+	sb.WriteString(sheet.Text.Render(r.amount))
+	if after != "" {
+		sb.WriteString(subdued.Render(after))
+	}
+	return sb.String()
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"strings"
@@ -11,7 +12,10 @@ import (
 	"github.com/spf13/viper"
 	"golang.org/x/term"
 
+	"github.com/DanStough/epok/internal/i18n"
 	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/parse"
+	"github.com/DanStough/epok/tz"
 )
 
 const (
@@ -35,7 +39,8 @@ const (
 var cfgFile string
 
 const (
-	groupIDEpochCommands = "epoch-manipulation"
+	groupIDEpochCommands    = "epoch-manipulation"
+	groupIDTimezoneCommands = "timezones"
 )
 
 // NewRootCMD creates the root command for the epok CLI application.
@@ -59,8 +64,8 @@ epok is a command line tool for viewing 👀 and generating unix epoch timestamp
 
 Some things you can do with epok:
   - fuzzy-parse timestamps from multiple precisions into human readable date-times.
-  - generate timestamps from multiple formats and expressions. (TBA)
-  - view and search system timezone information. (TBA)
+  - generate timestamps from multiple formats and expressions.
+  - view and search system timezone information.
 
 See the GitHub repository for more information: https://github.com/DanStough/epok`,
 		Example: `# Get the human readable version of an epoch timestamp
@@ -72,6 +77,9 @@ pbpaste | epok parse --output=simple
 # create a timestamp with nanosecond precision and save to the clipboard
 epok now --precision=nanosecond --output=simple | pbcopy
 `,
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+			return useZoneinfo()
+		},
 	}
 
 	// Persistent Flags
@@ -80,6 +88,15 @@ epok now --precision=nanosecond --output=simple | pbcopy
 	rootCmd.PersistentFlags().StringP("output", "o", "pretty",
 		"output format. Non-interactive outputs will automatically be downgraded to "+
 			"\"simple\" Valid options are: simple, json, pretty")
+	rootCmd.PersistentFlags().String("lang", "",
+		"language of dates, relative times and table headers. Numbers are grouped in pretty output. "+
+			"The default is English. Valid options are: "+strings.Join(i18n.Supported(), ", "))
+	rootCmd.PersistentFlags().String("zoneinfo", "",
+		"time zone database to load zones from: a directory of zone files or a zip like Go's zoneinfo.zip. "+
+			"The default is the system database, falling back to the tzdata built into epok with the "+
+			"timetzdata build tag.")
+	// Subcommands bind their own flags before they run, but the database is chosen before that.
+	cobra.CheckErr(viper.BindPFlag("zoneinfo", rootCmd.PersistentFlags().Lookup("zoneinfo")))
 
 	// Groups
 	groups := []*cobra.Group{
@@ -87,12 +104,25 @@ epok now --precision=nanosecond --output=simple | pbcopy
 			ID:    groupIDEpochCommands,
 			Title: "Epoch Manipulation",
 		},
+		{
+			ID:    groupIDTimezoneCommands,
+			Title: "Time Zones",
+		},
 	}
 	rootCmd.AddGroup(groups...)
 
 	// Subcommands
 	rootCmd.AddCommand(newNowCmd())
+	rootCmd.AddCommand(newAtCmd())
 	rootCmd.AddCommand(newParseCmd())
+	rootCmd.AddCommand(newExplainCmd())
+	rootCmd.AddCommand(newIDCmd())
+	rootCmd.AddCommand(newGenCmd())
+	rootCmd.AddCommand(newDecodeCmd())
+	rootCmd.AddCommand(newBetweenCmd())
+	rootCmd.AddCommand(newTimezoneCmd())
+	rootCmd.AddCommand(newMeetCmd())
+	rootCmd.AddCommand(newConvertCmd())
 
 	return rootCmd
 }
@@ -171,3 +201,67 @@ func getOutput() (outputMode, error) {
 	}
 	return output, nil
 }
+
+// output is implemented by the results of commands, to be written in every output mode.
+type output interface {
+	writePretty(w io.Writer) error
+	writeSimple(w io.Writer) error
+	writeJson(w io.Writer) error
+}
+
+// writeOutput writes a result in an output mode.
+func writeOutput(cmd *cobra.Command, mode outputMode, out output) error {
+	switch mode {
+	case outputModePretty:
+		return out.writePretty(cmd.OutOrStdout())
+	case outputModeSimple:
+		return out.writeSimple(cmd.OutOrStdout())
+	case outputModeJson:
+		return out.writeJson(cmd.OutOrStdout())
+	default:
+		return fmt.Errorf("unexpected output format: %s", mode)
+	}
+}
+
+// getLanguage reads the lang flag.
+func getLanguage() (i18n.Language, error) {
+	lang, err := i18n.Parse(viper.GetString("lang"))
+	if err != nil {
+		return i18n.Language{}, fmt.Errorf("invalid lang flag: %w", err)
+	}
+	return lang, nil
+}
+
+// useZoneinfo loads zones from the database of the zoneinfo flag.
+func useZoneinfo() error {
+	path := viper.GetString("zoneinfo")
+	if path == "" {
+		tz.Use(nil)
+		return nil
+	}
+
+	db, err := tz.OpenDatabase(path)
+	if err != nil {
+		return fmt.Errorf("invalid zoneinfo flag: %w", err)
+	}
+	tz.Use(&db)
+	return nil
+}
+
+// tzdataVersion is the tzdata release zones are loaded from, so results can be reproduced on other
+// machines. It's empty when the release can't be found.
+func tzdataVersion() string {
+	return tz.Current().Version()
+}
+
+// precisionUnits describes the values accepted by the precision flags.
+const precisionUnits = "valid units are seconds [s,secs], milliseconds [ms, millis], microseconds [us, micros], nanoseconds [ns, nanos], picoseconds [ps, picos], and femtoseconds [fs, femtos]"
+
+func getPrecision() (parse.Unit, error) {
+	str := viper.GetString("precision")
+	unit, err := parse.ParseUnit(str)
+	if err != nil {
+		return parse.Auto, fmt.Errorf("invalid precision flag: %s", str)
+	}
+	return unit, nil
+}
@@ -3,17 +3,34 @@ package cmd
 import (
 	"bytes"
 	"io"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 	"testing/synctest"
+	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	"github.com/DanStough/epok/tz"
+)
+
+// goZoneinfo is the database shipped with Go, and goTzdata is its release. Tests that print the release load
+// zones from it with --zoneinfo, so the output is the same on every machine.
+var (
+	goZoneinfo = filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip")
+	goTzdata   = tz.Database{Path: goZoneinfo}.Version()
 )
 
 // testCase is the structure of almost all command line tests.
 type testCase struct {
-	name string
-	args []string
-	in   string
+	name   string
+	args   []string
+	in     string
+	config string            // config is the contents of a YAML config file passed with --config.
+	env    map[string]string // env are environment variables set for the test, like TZ.
+	wait   time.Duration     // wait moves the fake clock forward before the command runs.
 
 	expectedError  string
 	expectedOutput []string
@@ -24,8 +41,24 @@ func testCommand(t *testing.T, tc testCase) {
 	// Using synctest here means the relative time in the output is fixed
 	synctest.Run(func() {
 		t.Run(tc.name, func(t *testing.T) {
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			args := tc.args
+			if tc.config != "" {
+				path := filepath.Join(t.TempDir(), ".epok.yaml")
+				require.NoError(t, os.WriteFile(path, []byte(tc.config), 0o600))
+				args = append(args, "--config", path)
+
+				// viper is global, so don't leak the config into other tests.
+				t.Cleanup(viper.Reset)
+			}
+
+			time.Sleep(tc.wait)
+
 			cmd := NewRootCMD()
-			cmd.SetArgs(tc.args)
+			cmd.SetArgs(args)
 
 			inStream := bytes.NewBufferString(tc.in)
 			cmd.SetIn(inStream)
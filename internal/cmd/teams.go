@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/DanStough/epok/tz"
+)
+
+// memberConfig is a member of a team roster from the config file, with their zone and optionally their
+// working hours. Rosters are lists of members, e.g.
+//
+//	teams:
+//	  platform:
+//	    - name: Alice
+//	      zone: America/Denver
+//	    - name: Ops
+//	      zone: Europe/Dublin
+//	      hours: 08:00-16:00
+//	      days: sun-thu
+//
+// or maps of names to zones, which teamMembers reads.
+type memberConfig struct {
+	Name  string
+	Zone  string
+	Hours string // Hours are the start and end of the working day, like "09:00-17:00".
+	Days  string // Days are the working days, like "mon-fri" or "sun,mon,tue".
+}
+
+const (
+	defaultWorkingHours = "09:00-17:00"
+	defaultWorkingDays  = "mon-fri"
+)
+
+// WorkingHours are the hours of the day and the days of the week a team member works, in their zone.
+type WorkingHours struct {
+	start, end time.Duration // start and end are since midnight. A shift past midnight ends before it starts.
+	days       [7]bool       // days are indexed by time.Weekday, and are the days shifts start on.
+}
+
+// parseWorkingHours reads hours like "09:00-17:00" and days like "mon-fri" or "mon,wed,fri".
+func parseWorkingHours(hours, days string) (WorkingHours, error) {
+	var wh WorkingHours
+
+	from, to, found := strings.Cut(hours, "-")
+	if !found {
+		return wh, fmt.Errorf("invalid hours %q, expected a range like %s", hours, defaultWorkingHours)
+	}
+	var err error
+	if wh.start, err = parseClock(from); err != nil {
+		return wh, fmt.Errorf("invalid hours %q: %w", hours, err)
+	}
+	if wh.end, err = parseClock(to); err != nil {
+		return wh, fmt.Errorf("invalid hours %q: %w", hours, err)
+	}
+	if wh.start == wh.end {
+		return wh, fmt.Errorf("invalid hours %q: the working day is empty", hours)
+	}
+
+	for _, part := range strings.Split(days, ",") {
+		first, last, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		start, ok := weekdays[strings.ToLower(first)]
+		if !ok {
+			return wh, fmt.Errorf("invalid days %q: unknown day %q", days, first)
+		}
+		end := start
+		if isRange {
+			if end, ok = weekdays[strings.ToLower(last)]; !ok {
+				return wh, fmt.Errorf("invalid days %q: unknown day %q", days, last)
+			}
+		}
+		// Ranges can wrap around the end of the week, like "sun-thu" or "fri-mon".
+		for d := start; ; d = (d + 1) % 7 {
+			wh.days[d] = true
+			if d == end {
+				break
+			}
+		}
+	}
+	return wh, nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// parseClock reads a time of day like "09:00" or "17:30".
+func parseClock(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	// 24:00 is the end of the day.
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Contains reports whether t, in the member's zone, is inside their working hours.
+func (wh WorkingHours) Contains(t time.Time) bool {
+	// Days with a daylight saving transition aren't 24 hours long, so use the wall clock.
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+
+	if wh.start < wh.end {
+		return wh.days[t.Weekday()] && clock >= wh.start && clock < wh.end
+	}
+	// A shift past midnight started today, or is the end of yesterday's.
+	return wh.days[t.Weekday()] && clock >= wh.start || wh.days[(t.Weekday()+6)%7] && clock < wh.end
+}
+
+// String writes the working hours like "09:00-17:00 Mon-Fri".
+func (wh WorkingHours) String() string {
+	clock := func(d time.Duration) string {
+		return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+	}
+	day := func(d time.Weekday) string {
+		return d.String()[:3]
+	}
+
+	var days []string
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if !wh.days[d] {
+			continue
+		}
+		// Runs of three or more days are joined, like "Mon-Fri".
+		end := d
+		for end < time.Saturday && wh.days[end+1] {
+			end++
+		}
+		if end-d >= 2 {
+			days = append(days, day(d)+"-"+day(end))
+			d = end
+			continue
+		}
+		days = append(days, day(d))
+	}
+	return fmt.Sprintf("%s-%s %s", clock(wh.start), clock(wh.end), strings.Join(days, ","))
+}
+
+// getTeam loads a team roster from the config file.
+func getTeam(name string) (map[string]localeZone, error) {
+	teams := viper.GetStringMap("teams")
+	key := strings.ToLower(name)
+	roster, ok := teams[key]
+	if !ok {
+		if len(teams) == 0 {
+			return nil, fmt.Errorf("unknown team %s, the config file doesn't define any teams", name)
+		}
+		names := make([]string, 0, len(teams))
+		for team := range teams {
+			names = append(names, team)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown team %s, the config file defines %s", name, strings.Join(names, ", "))
+	}
+
+	members, err := teamMembers(key, roster)
+	if err != nil {
+		return nil, fmt.Errorf("invalid team %s in config file: %w", name, err)
+	}
+	if len(members) == 0 {
+		return nil, fmt.Errorf("invalid team %s in config file: it has no members", name)
+	}
+
+	locales := make(map[string]localeZone, len(members))
+	for i, member := range members {
+		switch {
+		case member.Name == "":
+			return nil, fmt.Errorf("invalid member %d of team %s in config file: missing name", i+1, name)
+		case member.Zone == "":
+			return nil, fmt.Errorf("invalid member %s of team %s in config file: missing zone", member.Name, name)
+		}
+		if _, ok := locales[member.Name]; ok {
+			return nil, fmt.Errorf("invalid team %s in config file: %s is listed twice", name, member.Name)
+		}
+
+		loc, err := tz.Resolve(member.Zone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %s for member %s of team %s: %w", member.Zone, member.Name, name, err)
+		}
+		if member.Hours == "" {
+			member.Hours = defaultWorkingHours
+		}
+		if member.Days == "" {
+			member.Days = defaultWorkingDays
+		}
+		hours, err := parseWorkingHours(member.Hours, member.Days)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member %s of team %s in config file: %w", member.Name, name, err)
+		}
+
+		locales[member.Name] = localeZone{Location: loc, Hours: &hours}
+	}
+	return locales, nil
+}
+
+// teamMembers reads the members of a team, either as a list of members or as a map of names to zones,
+// e.g.
+//
+//	teams:
+//	  platform:
+//	    Alice: America/Denver
+//	    Ops: Europe/Dublin
+//
+// Members of a map work the default hours, and are ordered by name.
+func teamMembers(team string, roster any) ([]memberConfig, error) {
+	zones, ok := roster.(map[string]any)
+	if !ok {
+		var members []memberConfig
+		err := viper.UnmarshalKey("teams."+team, &members)
+		return members, err
+	}
+
+	// viper lowercases the keys of maps, so the names are read again from the config file to keep their case.
+	names := configKeys("teams", team)
+	members := make([]memberConfig, 0, len(zones))
+	for key, value := range zones {
+		name := key
+		if original, ok := names[key]; ok {
+			name = original
+		}
+		zone, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("the zone of member %s must be a string, got %v", name, value)
+		}
+		members = append(members, memberConfig{Name: name, Zone: zone})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Name < members[j].Name })
+	return members, nil
+}
+
+// configKeys reads the keys of a map in the config file with their case, by their lowercase form. The path
+// to the map is lowercase, like viper's keys. It's empty when the config file can't be read as YAML, which
+// JSON also is.
+func configKeys(path ...string) map[string]string {
+	data, err := os.ReadFile(viper.ConfigFileUsed())
+	if err != nil {
+		return nil
+	}
+	var node any
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil
+	}
+	for _, key := range path {
+		m, _ := node.(map[string]any)
+		node = nil
+		for k, v := range m {
+			if strings.ToLower(k) == key {
+				node = v
+				break
+			}
+		}
+	}
+
+	m, _ := node.(map[string]any)
+	keys := make(map[string]string, len(m))
+	for k := range m {
+		keys[strings.ToLower(k)] = k
+	}
+	return keys
+}
@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"testing"
+)
+
+const teamsConfig = `teams:
+  platform:
+    - name: Alice
+      zone: America/Denver
+    - name: Ops
+      zone: Europe/Berlin
+      hours: 08:00-16:00
+  night:
+    - name: Bob
+      zone: Asia/Tokyo
+      hours: 22:00-06:00
+  gulf:
+    - name: Carol
+      zone: Asia/Dubai
+      hours: 12:00-20:00
+      days: sun-thu
+`
+
+// Test_Teams covers team rosters and working hours from the config file.
+func Test_Teams(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "happy path - team with working hours",
+			args: []string{
+				"parse",
+				"-t",
+				"platform",
+				"1759154400",
+			},
+			config: teamsConfig,
+			expectedOutput: []string{
+				"LOCALE    DATE                          TIME        OFFSET    ZONE    DST    WORKING\n",
+				"Alice     Monday, September 29, 2025    08:00:00    -06:00    MDT     yes    no\n",
+				"Ops       Monday, September 29, 2025    16:00:00    +02:00    CEST    yes    no\n",
+			},
+		},
+		{
+			name: "happy path - team in json",
+			args: []string{
+				"parse",
+				"-ojson",
+				"--team",
+				"platform",
+				"1759158000",
+			},
+			config: teamsConfig,
+			expectedOutput: []string{
+				"{\"Name\":\"Alice\",\"Time\":\"2025-09-29T09:00:00-06:00\",\"Offset\":\"-06:00\",\"Abbreviation\":\"MDT\",\"DST\":true,\"Hours\":\"09:00-17:00 Mon-Fri\",\"Working\":true}",
+				"{\"Name\":\"Ops\",\"Time\":\"2025-09-29T17:00:00+02:00\",\"Offset\":\"+02:00\",\"Abbreviation\":\"CEST\",\"DST\":true,\"Hours\":\"08:00-16:00 Mon-Fri\",\"Working\":false}",
+			},
+		},
+		{
+			name: "happy path - default team from the config file",
+			args: []string{
+				"parse",
+				"1759154400",
+			},
+			config: "team: gulf\n" + teamsConfig,
+			expectedOutput: []string{
+				"Carol     Monday, September 29, 2025    18:00:00    +04:00    +04     no     yes\n",
+			},
+		},
+		{
+			name: "happy path - working days wrap around the week",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-t",
+				"gulf",
+				"1759500000",
+			},
+			config: teamsConfig,
+			expectedOutput: []string{
+				"\"Hours\":\"12:00-20:00 Sun-Thu\",\"Working\":false}",
+			},
+		},
+		{
+			name: "happy path - overnight shift",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-t",
+				"night",
+				"1759154400",
+			},
+			config: teamsConfig,
+			expectedOutput: []string{
+				"\"Time\":\"2025-09-29T23:00:00+09:00\"",
+				"\"Working\":true}",
+			},
+		},
+		{
+			name: "happy path - overnight shift ends the next day",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-t",
+				"night",
+				"1759514400",
+			},
+			config: teamsConfig,
+			expectedOutput: []string{
+				"\"Time\":\"2025-10-04T03:00:00+09:00\"",
+				"\"Working\":true}",
+			},
+		},
+		{
+			name: "happy path - overnight shift doesn't start on a day off",
+			args: []string{
+				"parse",
+				"-ojson",
+				"-t",
+				"night",
+				"1759586400",
+			},
+			config: teamsConfig,
+			expectedOutput: []string{
+				"\"Time\":\"2025-10-04T23:00:00+09:00\"",
+				"\"Working\":false}",
+			},
+		},
+		{
+			name: "happy path - localized working column",
+			args: []string{
+				"parse",
+				"-t",
+				"night",
+				"--lang",
+				"de",
+				"1759154400",
+			},
+			config: teamsConfig,
+			expectedOutput: []string{
+				"SOMMERZEIT    ARBEITSZEIT\n",
+			},
+		},
+		{
+			name: "happy path - timezone flag overrides the default team",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"1759154400",
+			},
+			config: "team: gulf\n" + teamsConfig,
+			expectedOutput: []string{
+				"LOCALE    DATE                          TIME        OFFSET    ZONE    DST\n",
+				"UTC       Monday, September 29, 2025    14:00:00    +00:00    UTC     no\n",
+			},
+		},
+		{
+			name: "team and timezone flags",
+			args: []string{
+				"parse",
+				"-z",
+				"UTC=UTC",
+				"-t",
+				"gulf",
+				"1759154400",
+			},
+			config:        teamsConfig,
+			expectedError: "the timezone and team flags can't be used together",
+		},
+		{
+			name: "unknown team",
+			args: []string{
+				"parse",
+				"-t",
+				"sales",
+				"1759154400",
+			},
+			config:        teamsConfig,
+			expectedError: "unknown team sales, the config file defines gulf, night, platform",
+		},
+		{
+			name: "no teams",
+			args: []string{
+				"parse",
+				"-t",
+				"sales",
+				"1759154400",
+			},
+			expectedError: "unknown team sales, the config file doesn't define any teams",
+		},
+		{
+			name: "invalid hours",
+			args: []string{
+				"parse",
+				"-t",
+				"platform",
+				"1759154400",
+			},
+			config:        "teams:\n  platform:\n    - name: Alice\n      zone: America/Denver\n      hours: 9-5\n",
+			expectedError: "invalid member Alice of team platform in config file: invalid hours \"9-5\": invalid time of day \"9\"",
+		},
+		{
+			name: "invalid days",
+			args: []string{
+				"parse",
+				"-t",
+				"platform",
+				"1759154400",
+			},
+			config:        "teams:\n  platform:\n    - name: Alice\n      zone: America/Denver\n      days: mon-fry\n",
+			expectedError: "invalid member Alice of team platform in config file: invalid days \"mon-fry\": unknown day \"fry\"",
+		},
+		{
+			name: "invalid member zone",
+			args: []string{
+				"parse",
+				"-t",
+				"platform",
+				"1759154400",
+			},
+			config:        "teams:\n  platform:\n    - name: Alice\n      zone: Mars/Olympus_Mons\n",
+			expectedError: "invalid timezone Mars/Olympus_Mons for member Alice of team platform: unknown time zone Mars/Olympus_Mons",
+		},
+		{
+			name: "member without a zone",
+			args: []string{
+				"parse",
+				"-t",
+				"platform",
+				"1759154400",
+			},
+			config:        "teams:\n  platform:\n    - name: Alice\n      hours: 09:00-17:00\n",
+			expectedError: "invalid member Alice of team platform in config file: missing zone",
+		},
+		{
+			name: "member without a name",
+			args: []string{
+				"parse",
+				"-t",
+				"platform",
+				"1759154400",
+			},
+			config:        "teams:\n  platform:\n    - zone: America/Denver\n",
+			expectedError: "invalid member 1 of team platform in config file: missing name",
+		},
+		{
+			name: "member listed twice",
+			args: []string{
+				"parse",
+				"-t",
+				"platform",
+				"1759154400",
+			},
+			config:        "teams:\n  platform:\n    - name: Alice\n      zone: America/Denver\n    - name: Alice\n      zone: Europe/Berlin\n",
+			expectedError: "invalid team platform in config file: Alice is listed twice",
+		},
+		{
+			name: "happy path - team as a map of names to zones",
+			args: []string{
+				"meet",
+				"-t",
+				"platform",
+				"--date",
+				"2025-09-29",
+				"--base",
+				"UTC",
+			},
+			config: "teams:\n  platform:\n    Alice: America/Denver\n    Ops: Europe/Dublin\n",
+			expectedOutput: []string{
+				"Alice    18 19 20 21 22 23 00 01 02  03  04  05  06  07  08  09* 10* 11* 12* 13* 14* 15* 16* 17\n",
+				"Ops      01 02 03 04 05 06 07 08 09* 10* 11* 12* 13* 14* 15* 16* 17  18  19  20  21  22  23  00\n",
+				"1759158000    2025-09-29T15:00:00Z    2025-09-29T16:00:00Z\n",
+			},
+		},
+		{
+			name: "member of a map without a zone",
+			args: []string{
+				"parse",
+				"-t",
+				"platform",
+				"1759154400",
+			},
+			config:        "teams:\n  platform:\n    Alice:\n      hours: 09:00-17:00\n",
+			expectedError: "invalid team platform in config file: the zone of member Alice must be a string, got map[hours:09:00-17:00]",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
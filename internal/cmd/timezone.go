@@ -0,0 +1,593 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/DanStough/epok/internal/styles"
+	"github.com/DanStough/epok/tz"
+)
+
+// timezoneSpecifiers describes the zones tz.Resolve accepts, for the help of the timezone flags.
+const timezoneSpecifiers = "Zones can be IANA names, offsets like +05:30 or UTC-8, abbreviations like PST, " +
+	"cities like tokyo, or POSIX TZ strings."
+
+// newTimezoneCmd creates the timezone subcommand, which groups the commands for exploring time zones.
+func newTimezoneCmd() *cobra.Command {
+	timezoneCmd := &cobra.Command{
+		Use:     "timezone",
+		Aliases: []string{"tz"},
+		Short:   "list, search and show time zones",
+		Long: `Use the timezone commands to explore the IANA time zone database: list the zones in a region,
+search them by name, city or abbreviation, and show the system zone and its upcoming transitions.`,
+		GroupID: groupIDTimezoneCommands,
+	}
+
+	timezoneCmd.AddCommand(newTimezoneListCmd())
+	timezoneCmd.AddCommand(newTimezoneSearchCmd())
+	timezoneCmd.AddCommand(newTimezoneShowCmd())
+	timezoneCmd.AddCommand(newTimezoneTransitionsCmd())
+	return timezoneCmd
+}
+
+// newTimezoneListCmd creates the timezone list subcommand.
+func newTimezoneListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list [region]",
+		Aliases: []string{"ls"},
+		Short:   "list time zones with their current offset",
+		Long: `Use the list command to show every zone in the time zone database with its current offset and
+abbreviation. A region, like Europe or America, only lists the zones in it.`,
+		Example: `# list every time zone
+epok timezone list
+
+# list the zones in Australia
+epok timezone list australia`,
+
+		Args: cobra.MaximumNArgs(1),
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTimezoneList(cmd, args)
+		},
+		SilenceUsage: true,
+	}
+}
+
+func runTimezoneList(cmd *cobra.Command, args []string) error {
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+
+	names, err := tz.Names()
+	if err != nil {
+		return fmt.Errorf("could not list time zones: %w", err)
+	}
+
+	if len(args) > 0 {
+		region := args[0]
+		filtered := names[:0]
+		for _, name := range names {
+			if strings.EqualFold(tz.Region(name), region) {
+				filtered = append(filtered, name)
+			}
+		}
+		if len(filtered) == 0 {
+			return fmt.Errorf("no time zones in region %s", region)
+		}
+		names = filtered
+	}
+
+	now := time.Now()
+	out := &timezoneListOutput{Now: now.UTC(), Tzdata: tzdataVersion()}
+	for _, name := range names {
+		loc, err := tz.LoadLocation(name)
+		if err != nil {
+			return fmt.Errorf("could not load time zone %s: %w", name, err)
+		}
+		out.Zones = append(out.Zones, newZoneInfo(tz.At(loc, now)))
+	}
+
+	return writeOutput(cmd, mode, out)
+}
+
+// newTimezoneSearchCmd creates the timezone search subcommand.
+func newTimezoneSearchCmd() *cobra.Command {
+	searchCmd := &cobra.Command{
+		Use:     "search query",
+		Aliases: []string{"find"},
+		Short:   "fuzzy-search time zones by name, city or abbreviation",
+		Long: `Use the search command to find time zones. The query is matched fuzzily against zone names and
+major cities, like "frisco" for San Francisco, and exactly against the abbreviations zones use, like PST.`,
+		Example: `# find the zone of a city
+epok timezone search san francisco
+
+# find the zones that use an abbreviation
+epok timezone search CEST`,
+
+		Args: cobra.MinimumNArgs(1),
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTimezoneSearch(cmd, args)
+		},
+		SilenceUsage: true,
+	}
+
+	searchCmd.Flags().IntP("limit", "n", 10, "maximum number of matches to show. Use 0 for all of them.")
+	return searchCmd
+}
+
+func runTimezoneSearch(cmd *cobra.Command, args []string) error {
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+
+	names, err := tz.Names()
+	if err != nil {
+		return fmt.Errorf("could not list time zones: %w", err)
+	}
+
+	query := strings.Join(args, " ")
+	now := time.Now()
+	matches := tz.Search(names, query, now)
+	if len(matches) == 0 {
+		return fmt.Errorf("no time zones match %q", query)
+	}
+	if limit := viper.GetInt("limit"); limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	out := &timezoneSearchOutput{Query: query, Now: now.UTC(), Tzdata: tzdataVersion()}
+	for _, match := range matches {
+		loc, err := tz.LoadLocation(match.Name)
+		if err != nil {
+			return fmt.Errorf("could not load time zone %s: %w", match.Name, err)
+		}
+		out.Matches = append(out.Matches, ZoneMatch{
+			ZoneInfo: newZoneInfo(tz.At(loc, now)),
+			Kind:     match.Kind,
+			Matched:  match.Matched,
+		})
+	}
+
+	return writeOutput(cmd, mode, out)
+}
+
+// newTimezoneShowCmd creates the timezone show subcommand.
+func newTimezoneShowCmd() *cobra.Command {
+	showCmd := &cobra.Command{
+		Use:   "show [zone]",
+		Short: "show a time zone and its upcoming transitions",
+		Long: `Use the show command to see the current offset of a zone and its upcoming transitions, like the
+start and end of daylight saving time. Without a zone it shows the system zone, and where it was
+resolved from: the TZ environment variable or /etc/localtime. The database zones are loaded from can be
+changed with the ZONEINFO environment variable.`,
+		Example: `# show the system time zone
+epok timezone show
+
+# show the next daylight saving transitions in London
+epok timezone show Europe/London --count 2`,
+
+		Args: cobra.MaximumNArgs(1),
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTimezoneShow(cmd, args)
+		},
+		SilenceUsage: true,
+	}
+
+	showCmd.Flags().Int("count", 4, "number of upcoming transitions to show")
+	return showCmd
+}
+
+func runTimezoneShow(cmd *cobra.Command, args []string) error {
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+
+	var loc *time.Location
+	source := ""
+	if len(args) > 0 {
+		loc, err = tz.Resolve(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid timezone %s: %w", args[0], err)
+		}
+	} else {
+		system, err := tz.DetectSystem()
+		if err != nil {
+			return fmt.Errorf("could not detect the system time zone: %w", err)
+		}
+		loc, source = system.Location, system.Source
+	}
+
+	now := time.Now()
+	db := tz.Current()
+	out := &timezoneShowOutput{
+		Zone:   newZoneInfo(tz.At(loc, now)),
+		Source: source,
+		Tzdata: db.Version(),
+		Time:   now.In(loc),
+		Now:    now.UTC(),
+	}
+	if db.Path != "" {
+		out.Database = fmt.Sprintf("%s (%s)", db.Path, db.Source)
+	}
+	for _, transition := range tz.Upcoming(loc, now, viper.GetInt("count")) {
+		out.Transitions = append(out.Transitions, newZoneTransition(transition))
+	}
+
+	return writeOutput(cmd, mode, out)
+}
+
+// newTimezoneTransitionsCmd creates the timezone transitions subcommand.
+func newTimezoneTransitionsCmd() *cobra.Command {
+	transitionsCmd := &cobra.Command{
+		Use:   "transitions zone",
+		Short: "list the transitions of a time zone in a year",
+		Long: `Use the transitions command to list every change in the offset or abbreviation of a zone in a
+year of its calendar, like the start and end of daylight saving time, and the wall-clock times each one
+skips or repeats.`,
+		Example: `# list this year's daylight saving transitions in New York
+epok timezone transitions America/New_York
+
+# list the transitions of another year
+epok timezone transitions Europe/London --year 1996`,
+
+		Args: cobra.ExactArgs(1),
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			bindFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTimezoneTransitions(cmd, args)
+		},
+		SilenceUsage: true,
+	}
+
+	transitionsCmd.Flags().Int("year", 0, "year to list the transitions of. The default is the current year.")
+	return transitionsCmd
+}
+
+func runTimezoneTransitions(cmd *cobra.Command, args []string) error {
+	mode, err := getOutput()
+	if err != nil {
+		return err
+	}
+
+	loc, err := tz.Resolve(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid timezone %s: %w", args[0], err)
+	}
+
+	now := time.Now()
+	year := viper.GetInt("year")
+	if year == 0 {
+		year = now.In(loc).Year()
+	}
+	if year < 1 || year > 9999 {
+		return fmt.Errorf("invalid year flag: %d, must be from 1 to 9999", year)
+	}
+
+	out := &timezoneTransitionsOutput{Zone: loc.String(), Year: year, Now: now.UTC(), Tzdata: tzdataVersion()}
+	for _, transition := range tz.InYear(loc, year) {
+		out.Transitions = append(out.Transitions, newZoneTransition(transition))
+	}
+
+	return writeOutput(cmd, mode, out)
+}
+
+// ZoneInfo is the offset and abbreviation a time zone uses at some instant.
+type ZoneInfo struct {
+	Name         string `json:",omitempty"`
+	Offset       string
+	Abbreviation string
+	DST          bool
+}
+
+func newZoneInfo(zone tz.Zone) ZoneInfo {
+	return ZoneInfo{
+		Name:         zone.Name,
+		Offset:       tz.FormatOffset(zone.Offset),
+		Abbreviation: zone.Abbreviation,
+		DST:          zone.DST,
+	}
+}
+
+// ZoneTransition is a change in the offset or abbreviation of a time zone.
+type ZoneTransition struct {
+	At     time.Time // At is always UTC.
+	Before ZoneInfo
+	After  ZoneInfo
+
+	// Shift is how far the clocks move, like "+1h" when daylight saving time starts.
+	Shift string
+
+	wallTimes string
+}
+
+func newZoneTransition(transition tz.Transition) ZoneTransition {
+	before, after := newZoneInfo(transition.Before), newZoneInfo(transition.After)
+	before.Name, after.Name = "", ""
+
+	shift := "0m"
+	switch {
+	case transition.Shift() > 0:
+		shift = "+" + formatDuration(transition.Shift())
+	case transition.Shift() < 0:
+		shift = "-" + formatDuration(-transition.Shift())
+	}
+
+	wall := ""
+	if from, to, effect := wallTimes(transition); effect != "" {
+		wall = fmt.Sprintf("%s to %s %s", from, to, effect)
+	}
+
+	return ZoneTransition{
+		At:        transition.At,
+		Before:    before,
+		After:     after,
+		Shift:     shift,
+		wallTimes: wall,
+	}
+}
+
+// String describes the transition, like "EST -05:00 → EDT -04:00".
+func (t ZoneTransition) String() string {
+	return fmt.Sprintf("%s %s → %s %s", t.Before.Abbreviation, t.Before.Offset, t.After.Abbreviation, t.After.Offset)
+}
+
+// ZoneMatch is a time zone found by a search.
+type ZoneMatch struct {
+	ZoneInfo
+	Kind    tz.MatchKind
+	Matched string
+}
+
+type timezoneListOutput struct {
+	Zones []ZoneInfo
+
+	// Derived
+	Now    time.Time // Now is always UTC time, since it shows up across all JSON outputs.
+	Tzdata string    `json:",omitempty"`
+}
+
+func (o *timezoneListOutput) rows() [][]string {
+	rows := make([][]string, 0, len(o.Zones))
+	for _, zone := range o.Zones {
+		rows = append(rows, []string{zone.Name, zone.Offset, zone.Abbreviation})
+	}
+	return rows
+}
+
+func (o *timezoneListOutput) writeSimple(w io.Writer) error {
+	return writeRows(w, []string{"Zone", "Offset", "Abbreviation"}, o.rows())
+}
+
+func (o *timezoneListOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+	_, err := lipgloss.Fprintln(w, dataTable(sheet, []string{"Zone", "Offset", "Abbreviation"}, o.rows()))
+	return err
+}
+
+func (o *timezoneListOutput) writeJson(w io.Writer) error {
+	return writeJsonLine(w, o, "timezone list")
+}
+
+type timezoneSearchOutput struct {
+	Query   string
+	Matches []ZoneMatch
+
+	// Derived
+	Now    time.Time // Now is always UTC time, since it shows up across all JSON outputs.
+	Tzdata string    `json:",omitempty"`
+}
+
+func (o *timezoneSearchOutput) rows() [][]string {
+	rows := make([][]string, 0, len(o.Matches))
+	for _, match := range o.Matches {
+		rows = append(rows, []string{match.Name, match.Offset, match.Abbreviation, fmt.Sprintf("%s %s", match.Kind, match.Matched)})
+	}
+	return rows
+}
+
+func (o *timezoneSearchOutput) writeSimple(w io.Writer) error {
+	return writeRows(w, []string{"Zone", "Offset", "Abbreviation", "Match"}, o.rows())
+}
+
+func (o *timezoneSearchOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+	_, err := lipgloss.Fprintln(w, dataTable(sheet, []string{"Zone", "Offset", "Abbreviation", "Match"}, o.rows()))
+	return err
+}
+
+func (o *timezoneSearchOutput) writeJson(w io.Writer) error {
+	return writeJsonLine(w, o, "timezone search")
+}
+
+type timezoneTransitionsOutput struct {
+	Zone        string
+	Year        int
+	Transitions []ZoneTransition
+
+	// Derived
+	Now    time.Time // Now is always UTC time, since it shows up across all JSON outputs.
+	Tzdata string    `json:",omitempty"`
+}
+
+func (o *timezoneTransitionsOutput) rows() [][]string {
+	rows := make([][]string, 0, len(o.Transitions))
+	for _, transition := range o.Transitions {
+		rows = append(rows, []string{transition.At.Format(time.RFC3339), transition.String(), transition.Shift, transition.wallTimes})
+	}
+	return rows
+}
+
+func (o *timezoneTransitionsOutput) writeSimple(w io.Writer) error {
+	if len(o.Transitions) == 0 {
+		_, err := fmt.Fprintf(w, "%s has no transitions in %d\n", o.Zone, o.Year)
+		return err
+	}
+	return writeRows(w, []string{"At", "Transition", "Shift", "Wall Times"}, o.rows())
+}
+
+func (o *timezoneTransitionsOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+	if len(o.Transitions) == 0 {
+		_, err := fmt.Fprintln(w, sheet.Text.Render(fmt.Sprintf("%s has no transitions in %d", o.Zone, o.Year)))
+		return err
+	}
+	_, err := lipgloss.Fprintln(w, dataTable(sheet, []string{"At", "Transition", "Shift", "Wall Times"}, o.rows()))
+	return err
+}
+
+func (o *timezoneTransitionsOutput) writeJson(w io.Writer) error {
+	return writeJsonLine(w, o, "timezone transitions")
+}
+
+type timezoneShowOutput struct {
+	Zone        ZoneInfo
+	Source      string `json:",omitempty"` // Source is only set for the system zone.
+	Database    string `json:",omitempty"`
+	Tzdata      string `json:",omitempty"` // Tzdata is the release of the database, like "2025b".
+	Time        time.Time
+	Transitions []ZoneTransition
+
+	// Derived
+	Now time.Time // Now is always UTC time, since it shows up across all JSON outputs.
+}
+
+// rows are the labels and values shared by the simple and pretty outputs.
+func (o *timezoneShowOutput) rows() [][2]string {
+	dst := "no"
+	if o.Zone.DST {
+		dst = "yes"
+	}
+
+	rows := [][2]string{{"Zone:", o.Zone.Name}}
+	if o.Source != "" {
+		rows = append(rows, [2]string{"Source:", o.Source})
+	}
+	if o.Database != "" {
+		rows = append(rows, [2]string{"Database:", o.Database})
+	}
+	tzdata := o.Tzdata
+	if tzdata == "" {
+		tzdata = "unknown"
+	}
+	rows = append(rows,
+		[2]string{"Tzdata:", tzdata},
+		[2]string{"Time:", o.Time.Format(time.RFC3339)},
+		[2]string{"Offset:", fmt.Sprintf("%s (%s)", o.Zone.Offset, o.Zone.Abbreviation)},
+		[2]string{"DST:", dst},
+	)
+
+	if len(o.Transitions) == 0 {
+		rows = append(rows, [2]string{"Transitions:", "none"})
+	}
+	for _, transition := range o.Transitions {
+		at := transition.At.Format(time.RFC3339)
+		rows = append(rows, [2]string{"Transition:", fmt.Sprintf("%s  %s", at, transition)})
+	}
+	return rows
+}
+
+func (o *timezoneShowOutput) writeSimple(w io.Writer) error {
+	var errs error
+
+	tw := tabwriter.NewWriter(w, 0, 0, 4, ' ', tabwriter.TabIndent)
+	for _, row := range o.rows() {
+		_, err := fmt.Fprintf(tw, "%s\t%s\n", row[0], row[1])
+		errs = errors.Join(errs, err)
+	}
+	return errors.Join(errs, tw.Flush())
+}
+
+func (o *timezoneShowOutput) writePretty(w io.Writer) error {
+	sheet := styles.NewEpokTheme().Sheet()
+
+	width := 0
+	for _, row := range o.rows() {
+		width = max(width, len(row[0]))
+	}
+
+	var errs error
+	for _, row := range o.rows() {
+		label := sheet.Keyword.Width(width).Render(row[0])
+		_, err := fmt.Fprintln(w, label, sheet.Text.Render(row[1]))
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
+
+func (o *timezoneShowOutput) writeJson(w io.Writer) error {
+	return writeJsonLine(w, o, "timezone show")
+}
+
+// writeRows writes a table for the simple output mode, with the headers in capitals.
+func writeRows(w io.Writer, headers []string, rows [][]string) error {
+	var errs error
+
+	tw := tabwriter.NewWriter(w, 0, 0, 4, ' ', tabwriter.TabIndent)
+	_, err := fmt.Fprintln(tw, strings.ToUpper(strings.Join(headers, "\t")))
+	errs = errors.Join(errs, err)
+
+	for _, row := range rows {
+		_, err = fmt.Fprintln(tw, strings.Join(row, "\t"))
+		errs = errors.Join(errs, err)
+	}
+	return errors.Join(errs, tw.Flush())
+}
+
+// dataTable renders a table for the pretty output mode, with the same styles as the locale table.
+func dataTable(sheet *styles.Sheet, headers []string, rows [][]string) *table.Table {
+	return table.New().
+		Border(sheet.Table.BorderThickness).
+		BorderStyle(sheet.Table.Border).
+		StyleFunc(func(row, _ int) lipgloss.Style {
+			switch {
+			case row == table.HeaderRow:
+				return sheet.Table.Header
+			case row%2 == 0:
+				return sheet.Table.EvenRow
+			default:
+				return sheet.Table.OddRow
+			}
+		}).
+		Headers(headers...).
+		Rows(rows...)
+}
+
+// writeJsonLine writes a result as a line of JSON.
+func writeJsonLine(w io.Writer, v any, name string) error {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("could not marshal %s output JSON: %w", name, err)
+	}
+	bytes = append(bytes, '\n')
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("could not write %s output: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func Test_Timezone(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "list - region",
+			args: []string{
+				"timezone",
+				"list",
+				"australia",
+			},
+			expectedOutput: []string{
+				"ZONE                     OFFSET    ABBREVIATION\n",
+				"Australia/Perth          +08:00    AWST\n",
+				"Australia/Sydney         +11:00    AEDT\n",
+			},
+		},
+		{
+			name: "list - json",
+			args: []string{
+				"tz",
+				"ls",
+				"Asia",
+				"--output",
+				"json",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			expectedOutput: []string{
+				`{"Name":"Asia/Kolkata","Offset":"+05:30","Abbreviation":"IST","DST":false}`,
+				`"Now":"2000-01-01T00:00:00Z","Tzdata":"` + goTzdata + `"}`,
+			},
+		},
+		{
+			name: "list - unknown region",
+			args: []string{
+				"timezone",
+				"list",
+				"Atlantis",
+			},
+			expectedError: "no time zones in region Atlantis",
+		},
+		{
+			name: "search - city",
+			args: []string{
+				"timezone",
+				"search",
+				"frisco",
+			},
+			expectedOutput: []string{
+				"ZONE                   OFFSET    ABBREVIATION    MATCH\n",
+				"America/Los_Angeles    -08:00    PST             city San Francisco\n",
+			},
+		},
+		{
+			name: "search - abbreviation",
+			args: []string{
+				"timezone",
+				"find",
+				"CEST",
+				"--limit",
+				"0",
+				"--output",
+				"json",
+			},
+			expectedOutput: []string{
+				`"Query":"CEST"`,
+				`{"Name":"Europe/Berlin","Offset":"+01:00","Abbreviation":"CET","DST":false,"Kind":"abbreviation","Matched":"CEST"}`,
+			},
+		},
+		{
+			name: "search - no matches",
+			args: []string{
+				"timezone",
+				"search",
+				"qqqq",
+			},
+			expectedError: `no time zones match "qqqq"`,
+		},
+		{
+			name: "show - zone",
+			args: []string{
+				"timezone",
+				"show",
+				"America/New_York",
+				"--count",
+				"2",
+			},
+			expectedOutput: []string{
+				"Zone:          America/New_York\n",
+				"Time:          1999-12-31T19:00:00-05:00\n",
+				"Offset:        -05:00 (EST)\n",
+				"DST:           no\n",
+				"Transition:    2000-04-02T07:00:00Z  EST -05:00 → EDT -04:00\n",
+				"Transition:    2000-10-29T06:00:00Z  EDT -04:00 → EST -05:00\n",
+			},
+		},
+		{
+			name: "show - no transitions",
+			args: []string{
+				"timezone",
+				"show",
+				"Asia/Tokyo",
+			},
+			expectedOutput: []string{
+				"Offset:         +09:00 (JST)\n",
+				"Transitions:    none\n",
+			},
+		},
+		{
+			name: "show - system",
+			args: []string{
+				"timezone",
+				"show",
+				"--output",
+				"json",
+			},
+			env: map[string]string{
+				"TZ": "Europe/London",
+			},
+			expectedOutput: []string{
+				`"Zone":{"Name":"Europe/London","Offset":"+00:00","Abbreviation":"GMT","DST":false},"Source":"TZ"`,
+				`"Transitions":[{"At":"2000-03-26T01:00:00Z","Before":{"Offset":"+00:00","Abbreviation":"GMT","DST":false},"After":{"Offset":"+01:00","Abbreviation":"BST","DST":true},"Shift":"+1h"}`,
+			},
+		},
+		{
+			name: "show - system with an invalid TZ",
+			args: []string{
+				"timezone",
+				"show",
+			},
+			env: map[string]string{
+				"TZ": "EST5EDT,M3.2.0,M11.1.0",
+			},
+			expectedOutput: []string{
+				"Zone:           UTC\n",
+				"Source:         TZ (invalid, using UTC)\n",
+			},
+		},
+		{
+			name: "show - zip shipped with Go",
+			args: []string{
+				"timezone",
+				"show",
+				"UTC",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			expectedOutput: []string{
+				"Database:       " + goZoneinfo + " (custom)\n",
+				"Tzdata:         " + goTzdata + "\n",
+			},
+		},
+		{
+			name: "show - zoneinfo from the config file",
+			args: []string{
+				"timezone",
+				"show",
+				"Asia/Tokyo",
+				"--output",
+				"json",
+			},
+			config: "zoneinfo: " + goZoneinfo + "\n",
+			expectedOutput: []string{
+				`"Database":"` + goZoneinfo + ` (custom)","Tzdata":"` + goTzdata + `","Time"`,
+			},
+		},
+		{
+			name: "show - invalid zoneinfo",
+			args: []string{
+				"timezone",
+				"show",
+				"UTC",
+				"--zoneinfo",
+				"timezone_test.go",
+			},
+			expectedError: "invalid zoneinfo flag: timezone_test.go is not a directory or a zip file",
+		},
+		{
+			name: "show - zone missing from the database",
+			args: []string{
+				"timezone",
+				"show",
+				"Mars/Olympus_Mons",
+				"--zoneinfo",
+				goZoneinfo,
+			},
+			expectedError: "invalid timezone Mars/Olympus_Mons: unknown time zone Mars/Olympus_Mons",
+		},
+		{
+			name: "show - invalid zone",
+			args: []string{
+				"timezone",
+				"show",
+				"Mars/Olympus_Mons",
+			},
+			expectedError: "invalid timezone Mars/Olympus_Mons: unknown time zone Mars/Olympus_Mons",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
+
+func Test_TimezoneTransitions(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "happy path - current year",
+			args: []string{
+				"timezone",
+				"transitions",
+				"America/New_York",
+			},
+			expectedOutput: []string{
+				"AT                      TRANSITION                 SHIFT    WALL TIMES\n",
+				// It's still 1999 in New York at the start of 2000 in UTC.
+				"1999-04-04T07:00:00Z    EST -05:00 → EDT -04:00    +1h      02:00 to 03:00 skipped\n",
+				"1999-10-31T06:00:00Z    EDT -04:00 → EST -05:00    -1h      01:00 to 02:00 repeated\n",
+			},
+		},
+		{
+			name: "happy path - year",
+			args: []string{
+				"timezone",
+				"transitions",
+				"Europe/Moscow",
+				"--year",
+				"2014",
+				"--output",
+				"json",
+			},
+			expectedOutput: []string{
+				`{"Zone":"Europe/Moscow","Year":2014,"Transitions":[{"At":"2014-10-25T22:00:00Z",` +
+					`"Before":{"Offset":"+04:00","Abbreviation":"MSK","DST":false},` +
+					`"After":{"Offset":"+03:00","Abbreviation":"MSK","DST":false},"Shift":"-1h"}]`,
+			},
+		},
+		{
+			name: "happy path - no transitions",
+			args: []string{
+				"timezone",
+				"transitions",
+				"Asia/Tokyo",
+			},
+			expectedOutput: []string{
+				"Asia/Tokyo has no transitions in 2000\n",
+			},
+		},
+		{
+			name: "invalid year",
+			args: []string{
+				"timezone",
+				"transitions",
+				"UTC",
+				"--year",
+				"-1",
+			},
+			expectedError: "invalid year flag: -1, must be from 1 to 9999",
+		},
+		{
+			name: "invalid zone",
+			args: []string{
+				"timezone",
+				"transitions",
+				"Mars/Olympus_Mons",
+			},
+			expectedError: "invalid timezone Mars/Olympus_Mons: unknown time zone Mars/Olympus_Mons",
+		},
+	}
+
+	for _, tc := range testCases {
+		testCommand(t, tc)
+	}
+}
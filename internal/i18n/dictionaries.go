@@ -0,0 +1,214 @@
+package i18n
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/DanStough/epok/natural"
+)
+
+// dictionary holds the words and patterns of a language.
+type dictionary struct {
+	tag language.Tag
+
+	months      [12]string
+	weekdays    [7]string // weekdays start on Sunday, like time.Weekday.
+	datePattern string    // datePattern is the full CLDR date pattern.
+	headers     [7]string
+	yesNo       [2]string
+
+	// units are the names of the units in a relative time, as fmt patterns for the amount.
+	units     map[natural.Unit]unitNames
+	separator string
+	past      string
+	future    string
+	now       string
+}
+
+// unitNames are the plural forms of a unit. Every supported language only needs "one" and "other".
+type unitNames struct {
+	one   string
+	other string
+}
+
+var english = &dictionary{
+	tag: language.AmericanEnglish,
+	months: [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September",
+		"October", "November", "December"},
+	weekdays:    [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	datePattern: "EEEE, MMMM d, y",
+	headers:     [7]string{"Locale", "Date", "Time", "Offset", "Zone", "DST", "Working"},
+	yesNo:       [2]string{"no", "yes"},
+	units: map[natural.Unit]unitNames{
+		natural.Year:   {"%d year", "%d years"},
+		natural.Month:  {"%d month", "%d months"},
+		natural.Day:    {"%d day", "%d days"},
+		natural.Hour:   {"%d hour", "%d hours"},
+		natural.Minute: {"%d minute", "%d minutes"},
+		natural.Second: {"%d second", "%d seconds"},
+	},
+	separator: ", ",
+	past:      "%s ago",
+	future:    "%s from now",
+	now:       "now",
+}
+
+// britishEnglish only differs from English in the order of the date.
+var britishEnglish = func() *dictionary {
+	dict := *english
+	dict.tag = language.BritishEnglish
+	dict.datePattern = "EEEE d MMMM y"
+	return &dict
+}()
+
+// dictionaries are the supported languages. The first one is the default for the matcher.
+var dictionaries = []*dictionary{
+	english,
+	britishEnglish,
+	{
+		tag: language.German,
+		months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September",
+			"Oktober", "November", "Dezember"},
+		weekdays:    [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		datePattern: "EEEE, d. MMMM y",
+		headers:     [7]string{"Ort", "Datum", "Uhrzeit", "Versatz", "Zone", "Sommerzeit", "Arbeitszeit"},
+		yesNo:       [2]string{"nein", "ja"},
+		units: map[natural.Unit]unitNames{
+			natural.Year:   {"%d Jahr", "%d Jahren"},
+			natural.Month:  {"%d Monat", "%d Monaten"},
+			natural.Day:    {"%d Tag", "%d Tagen"},
+			natural.Hour:   {"%d Stunde", "%d Stunden"},
+			natural.Minute: {"%d Minute", "%d Minuten"},
+			natural.Second: {"%d Sekunde", "%d Sekunden"},
+		},
+		separator: ", ",
+		past:      "vor %s",
+		future:    "in %s",
+		now:       "jetzt",
+	},
+	{
+		tag: language.French,
+		months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre",
+			"octobre", "novembre", "décembre"},
+		weekdays:    [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		datePattern: "EEEE d MMMM y",
+		headers:     [7]string{"Lieu", "Date", "Heure", "Décalage", "Fuseau", "Heure d'été", "Heures ouvrées"},
+		yesNo:       [2]string{"non", "oui"},
+		units: map[natural.Unit]unitNames{
+			natural.Year:   {"%d an", "%d ans"},
+			natural.Month:  {"%d mois", "%d mois"},
+			natural.Day:    {"%d jour", "%d jours"},
+			natural.Hour:   {"%d heure", "%d heures"},
+			natural.Minute: {"%d minute", "%d minutes"},
+			natural.Second: {"%d seconde", "%d secondes"},
+		},
+		separator: ", ",
+		past:      "il y a %s",
+		future:    "dans %s",
+		now:       "maintenant",
+	},
+	{
+		tag: language.Spanish,
+		months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre",
+			"octubre", "noviembre", "diciembre"},
+		weekdays:    [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		datePattern: "EEEE, d 'de' MMMM 'de' y",
+		headers:     [7]string{"Lugar", "Fecha", "Hora", "Desfase", "Zona", "Horario de verano", "Horario laboral"},
+		yesNo:       [2]string{"no", "sí"},
+		units: map[natural.Unit]unitNames{
+			natural.Year:   {"%d año", "%d años"},
+			natural.Month:  {"%d mes", "%d meses"},
+			natural.Day:    {"%d día", "%d días"},
+			natural.Hour:   {"%d hora", "%d horas"},
+			natural.Minute: {"%d minuto", "%d minutos"},
+			natural.Second: {"%d segundo", "%d segundos"},
+		},
+		separator: ", ",
+		past:      "hace %s",
+		future:    "dentro de %s",
+		now:       "ahora",
+	},
+	{
+		tag: language.Italian,
+		months: [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto",
+			"settembre", "ottobre", "novembre", "dicembre"},
+		weekdays:    [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		datePattern: "EEEE d MMMM y",
+		headers:     [7]string{"Luogo", "Data", "Ora", "Scarto", "Fuso", "Ora legale", "Orario di lavoro"},
+		yesNo:       [2]string{"no", "sì"},
+		units: map[natural.Unit]unitNames{
+			natural.Year:   {"%d anno", "%d anni"},
+			natural.Month:  {"%d mese", "%d mesi"},
+			natural.Day:    {"%d giorno", "%d giorni"},
+			natural.Hour:   {"%d ora", "%d ore"},
+			natural.Minute: {"%d minuto", "%d minuti"},
+			natural.Second: {"%d secondo", "%d secondi"},
+		},
+		separator: ", ",
+		past:      "%s fa",
+		future:    "tra %s",
+		now:       "ora",
+	},
+	{
+		tag: language.Portuguese,
+		months: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro",
+			"outubro", "novembro", "dezembro"},
+		weekdays: [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira",
+			"sexta-feira", "sábado"},
+		datePattern: "EEEE, d 'de' MMMM 'de' y",
+		headers:     [7]string{"Local", "Data", "Hora", "Deslocamento", "Fuso", "Horário de verão", "Horário de trabalho"},
+		yesNo:       [2]string{"não", "sim"},
+		units: map[natural.Unit]unitNames{
+			natural.Year:   {"%d ano", "%d anos"},
+			natural.Month:  {"%d mês", "%d meses"},
+			natural.Day:    {"%d dia", "%d dias"},
+			natural.Hour:   {"%d hora", "%d horas"},
+			natural.Minute: {"%d minuto", "%d minutos"},
+			natural.Second: {"%d segundo", "%d segundos"},
+		},
+		separator: ", ",
+		past:      "há %s",
+		future:    "em %s",
+		now:       "agora",
+	},
+	{
+		tag: language.Dutch,
+		months: [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september",
+			"oktober", "november", "december"},
+		weekdays:    [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		datePattern: "EEEE d MMMM y",
+		headers:     [7]string{"Plaats", "Datum", "Tijd", "Verschil", "Zone", "Zomertijd", "Werktijd"},
+		yesNo:       [2]string{"nee", "ja"},
+		units: map[natural.Unit]unitNames{
+			natural.Year:   {"%d jaar", "%d jaar"},
+			natural.Month:  {"%d maand", "%d maanden"},
+			natural.Day:    {"%d dag", "%d dagen"},
+			natural.Hour:   {"%d uur", "%d uur"},
+			natural.Minute: {"%d minuut", "%d minuten"},
+			natural.Second: {"%d seconde", "%d seconden"},
+		},
+		separator: ", ",
+		past:      "%s geleden",
+		future:    "over %s",
+		now:       "nu",
+	},
+	{
+		tag:         language.Japanese,
+		months:      [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		weekdays:    [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		datePattern: "y年M月d日EEEE",
+		headers:     [7]string{"場所", "日付", "時刻", "オフセット", "タイムゾーン", "夏時間", "勤務時間"},
+		yesNo:       [2]string{"いいえ", "はい"},
+		units: map[natural.Unit]unitNames{
+			natural.Year:   {"%d年", "%d年"},
+			natural.Month:  {"%dか月", "%dか月"},
+			natural.Day:    {"%d日", "%d日"},
+			natural.Hour:   {"%d時間", "%d時間"},
+			natural.Minute: {"%d分", "%d分"},
+			natural.Second: {"%d秒", "%d秒"},
+		},
+		separator: "",
+		past:      "%s前",
+		future:    "%s後",
+		now:       "今",
+	},
+}
@@ -0,0 +1,259 @@
+// Package i18n localizes the dates, relative times, numbers and table headers shown by epok.
+//
+// golang.org/x/text matches the requested language and provides the CLDR plural rules and number
+// symbols. It doesn't include month and weekday names or date patterns, so those are copied from CLDR
+// for each supported language.
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+
+	"github.com/DanStough/epok/natural"
+)
+
+var ErrUnsupported = errors.New("unsupported language")
+
+var matcher = language.NewMatcher(supportedTags())
+
+// Language formats output for a supported language. The zero Language is English without digit grouping,
+// which is what epok shows when no language is set.
+type Language struct {
+	dict *dictionary
+
+	group   string // group separates the thousands of a number, e.g. "." in German.
+	decimal string // decimal separates the fraction of a number, e.g. "," in German.
+}
+
+// Parse finds the supported language closest to a BCP 47 tag, like "de", "en-GB" or "pt-BR". An empty
+// string is the zero Language.
+func Parse(s string) (Language, error) {
+	if s == "" {
+		return Language{}, nil
+	}
+
+	tag, err := language.Parse(s)
+	if err != nil {
+		return Language{}, fmt.Errorf("%w: %s", ErrUnsupported, s)
+	}
+	_, index, confidence := matcher.Match(tag)
+	if confidence == language.No {
+		return Language{}, fmt.Errorf("%w: %s", ErrUnsupported, s)
+	}
+
+	dict := dictionaries[index]
+	p := message.NewPrinter(dict.tag)
+	return Language{
+		dict:    dict,
+		group:   between(p.Sprint(number.Decimal(1_000_000)), "1", "0"),
+		decimal: between(p.Sprint(number.Decimal(1.5)), "1", "5"),
+	}, nil
+}
+
+// Supported lists the tags of the supported languages.
+func Supported() []string {
+	tags := make([]string, 0, len(dictionaries))
+	for _, dict := range dictionaries {
+		tags = append(tags, dict.tag.String())
+	}
+	return tags
+}
+
+// String returns the tag of the language.
+func (l Language) String() string {
+	return l.dictionary().tag.String()
+}
+
+// Date writes the weekday and date of t with the full CLDR date pattern of the language, like
+// "Saturday, June 28, 2025" in English or "Samstag, 28. Juni 2025" in German.
+func (l Language) Date(t time.Time) string {
+	dict := l.dictionary()
+
+	var sb strings.Builder
+	pattern := dict.datePattern
+	for len(pattern) > 0 {
+		// Quoted text is written as is.
+		if pattern[0] == '\'' {
+			end := strings.IndexByte(pattern[1:], '\'')
+			if end < 0 {
+				end = len(pattern) - 1
+			}
+			sb.WriteString(pattern[1 : end+1])
+			pattern = pattern[min(end+2, len(pattern)):]
+			continue
+		}
+
+		field := fieldLength(pattern)
+		switch pattern[:field] {
+		case "EEEE":
+			sb.WriteString(dict.weekdays[t.Weekday()])
+		case "MMMM":
+			sb.WriteString(dict.months[t.Month()-1])
+		case "M":
+			fmt.Fprintf(&sb, "%d", t.Month())
+		case "d":
+			fmt.Fprintf(&sb, "%d", t.Day())
+		case "y":
+			fmt.Fprintf(&sb, "%d", t.Year())
+		default:
+			sb.WriteString(pattern[:field])
+		}
+		pattern = pattern[field:]
+	}
+	return sb.String()
+}
+
+// Headers returns the column names of the locale table: the locale, date, time, offset, zone
+// abbreviation, whether daylight saving time is observed, and whether a team member is inside their
+// working hours.
+func (l Language) Headers() []string {
+	headers := l.dictionary().headers
+	return headers[:]
+}
+
+// YesNo returns the word for yes or no, like "ja" or "nein".
+func (l Language) YesNo(b bool) string {
+	if b {
+		return l.dictionary().yesNo[1]
+	}
+	return l.dictionary().yesNo[0]
+}
+
+// Relative describes a span from now, like the one returned by natural.Approximate, e.g. "6 years,
+// 3 months ago" or "vor 6 Jahren, 3 Monaten".
+func (l Language) Relative(span natural.Span) string {
+	dict := l.dictionary()
+	amount := l.Amount(span)
+
+	switch {
+	case amount == "":
+		return dict.now
+	case span.Years < 0 || span.Months < 0 || span.Days < 0 || span.Hours < 0 || span.Minutes < 0 || span.Seconds < 0:
+		return fmt.Sprintf(dict.past, amount)
+	default:
+		return fmt.Sprintf(dict.future, amount)
+	}
+}
+
+// Amount describes the size of a span without its direction, like "6 years, 3 months". It is empty
+// when the span has no whole seconds.
+func (l Language) Amount(span natural.Span) string {
+	dict := l.dictionary()
+
+	var parts []string
+	for _, part := range []struct {
+		n    int
+		unit natural.Unit
+	}{
+		{n: span.Years, unit: natural.Year},
+		{n: span.Months, unit: natural.Month},
+		{n: span.Days, unit: natural.Day},
+		{n: span.Hours, unit: natural.Hour},
+		{n: span.Minutes, unit: natural.Minute},
+		{n: span.Seconds, unit: natural.Second},
+	} {
+		if part.n == 0 {
+			continue
+		}
+		n := max(part.n, -part.n)
+
+		names := dict.units[part.unit]
+		name := names.other
+		if plural.Cardinal.MatchPlural(dict.tag, n, 0, 0, 0, 0) == plural.One {
+			name = names.one
+		}
+		parts = append(parts, fmt.Sprintf(name, n))
+	}
+	return strings.Join(parts, dict.separator)
+}
+
+// Number groups the digits of a decimal number, like an epoch, and uses the decimal separator of the
+// language, e.g. "1.751.074.598,5" in German. Anything other than a number is returned unchanged, and so
+// is every number for the zero Language.
+func (l Language) Number(s string) string {
+	if l.group == "" {
+		return s
+	}
+
+	sign, whole := "", s
+	if strings.HasPrefix(whole, "-") {
+		sign, whole = "-", whole[1:]
+	}
+	whole, fraction, hasFraction := strings.Cut(whole, ".")
+	if !isDigits(whole) || (hasFraction && !isDigits(fraction)) {
+		return s
+	}
+
+	var sb strings.Builder
+	sb.WriteString(sign)
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			sb.WriteString(l.group)
+		}
+		sb.WriteRune(r)
+	}
+	if hasFraction {
+		sb.WriteString(l.decimal + fraction)
+	}
+	return sb.String()
+}
+
+func (l Language) dictionary() *dictionary {
+	if l.dict == nil {
+		return english
+	}
+	return l.dict
+}
+
+func supportedTags() []language.Tag {
+	tags := make([]language.Tag, 0, len(dictionaries))
+	for _, dict := range dictionaries {
+		tags = append(tags, dict.tag)
+	}
+	return tags
+}
+
+// fieldLength returns the length of the run of the same letter at the start of a date pattern, or 1
+// for anything that isn't a letter.
+func fieldLength(pattern string) int {
+	c := pattern[0]
+	if !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') {
+		// Copy multi-byte characters, like the 年 in Japanese patterns, whole.
+		_, size := utf8.DecodeRuneInString(pattern)
+		return size
+	}
+	n := 1
+	for n < len(pattern) && pattern[n] == c {
+		n++
+	}
+	return n
+}
+
+// between returns the text between the first prefix and the next occurrence of stop.
+func between(s, prefix, stop string) string {
+	s = strings.TrimPrefix(s, prefix)
+	if i := strings.Index(s, stop); i >= 0 {
+		return s[:i]
+	}
+	return ""
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,173 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DanStough/epok/natural"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		err      error
+	}{
+		{name: "empty is English", input: "", expected: "en-US"},
+		{name: "exact", input: "de", expected: "de"},
+		{name: "region", input: "en-GB", expected: "en-GB"},
+		{name: "closest region", input: "en-AU", expected: "en-GB"},
+		{name: "closest language", input: "pt-BR", expected: "pt"},
+		{name: "unsupported", input: "zh", err: ErrUnsupported},
+		{name: "invalid tag", input: "!!", err: ErrUnsupported},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, err := Parse(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Parse() error = %v, expected %v", err, tt.err)
+			}
+			if err == nil && lang.String() != tt.expected {
+				t.Errorf("Parse() = %s, expected %s", lang, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	date := time.Date(2025, time.June, 28, 1, 36, 38, 0, time.UTC)
+	past := natural.Span{Years: -6, Months: -1}
+	future := natural.Span{Days: 1, Hours: 4}
+
+	tests := []struct {
+		lang   string
+		date   string
+		past   string
+		future string
+		number string
+		dst    string
+	}{
+		{
+			lang:   "",
+			date:   "Saturday, June 28, 2025",
+			past:   "6 years, 1 month ago",
+			future: "1 day, 4 hours from now",
+			number: "1751074598.5",
+			dst:    "yes",
+		},
+		{
+			lang:   "en",
+			date:   "Saturday, June 28, 2025",
+			past:   "6 years, 1 month ago",
+			future: "1 day, 4 hours from now",
+			number: "1,751,074,598.5",
+			dst:    "yes",
+		},
+		{
+			lang:   "en-GB",
+			date:   "Saturday 28 June 2025",
+			past:   "6 years, 1 month ago",
+			future: "1 day, 4 hours from now",
+			number: "1,751,074,598.5",
+			dst:    "yes",
+		},
+		{
+			lang:   "de",
+			date:   "Samstag, 28. Juni 2025",
+			past:   "vor 6 Jahren, 1 Monat",
+			future: "in 1 Tag, 4 Stunden",
+			number: "1.751.074.598,5",
+			dst:    "ja",
+		},
+		{
+			lang:   "fr",
+			date:   "samedi 28 juin 2025",
+			past:   "il y a 6 ans, 1 mois",
+			future: "dans 1 jour, 4 heures",
+			number: "1\u00a0751\u00a0074\u00a0598,5",
+			dst:    "oui",
+		},
+		{
+			lang:   "es",
+			date:   "sábado, 28 de junio de 2025",
+			past:   "hace 6 años, 1 mes",
+			future: "dentro de 1 día, 4 horas",
+			number: "1.751.074.598,5",
+			dst:    "sí",
+		},
+		{
+			lang:   "ja",
+			date:   "2025年6月28日土曜日",
+			past:   "6年1か月前",
+			future: "1日4時間後",
+			number: "1,751,074,598.5",
+			dst:    "はい",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			lang, err := Parse(tt.lang)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if actual := lang.Date(date); actual != tt.date {
+				t.Errorf("Date() = %q, expected %q", actual, tt.date)
+			}
+			if actual := lang.Relative(past); actual != tt.past {
+				t.Errorf("Relative() = %q, expected %q", actual, tt.past)
+			}
+			if actual := lang.Relative(future); actual != tt.future {
+				t.Errorf("Relative() = %q, expected %q", actual, tt.future)
+			}
+			if actual := lang.Number("1751074598.5"); actual != tt.number {
+				t.Errorf("Number() = %q, expected %q", actual, tt.number)
+			}
+			if actual := lang.YesNo(true); actual != tt.dst {
+				t.Errorf("YesNo() = %q, expected %q", actual, tt.dst)
+			}
+		})
+	}
+}
+
+func TestRelativeNow(t *testing.T) {
+	lang, err := Parse("nl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual := lang.Relative(natural.Span{Nanoseconds: -5}); actual != "nu" {
+		t.Errorf("Relative() = %q, expected %q", actual, "nu")
+	}
+}
+
+func TestNumber(t *testing.T) {
+	lang, err := Parse("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "598", expected: "598"},
+		{input: "1000", expected: "1.000"},
+		{input: "-62135596800", expected: "-62.135.596.800"},
+		{input: "1751074598000000000000000", expected: "1.751.074.598.000.000.000.000.000"},
+		{input: "45836.0669", expected: "45.836,0669"},
+		{input: "2025-06-28T01:36:38Z", expected: "2025-06-28T01:36:38Z"},
+		{input: "1.2.3", expected: "1.2.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if actual := lang.Number(tt.input); actual != tt.expected {
+				t.Errorf("Number() = %q, expected %q", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -168,6 +168,7 @@ type scheme struct {
 	TableBorder            color.Color
 	TableCellText          color.Color
 	TableCellTextAlternate color.Color
+	TableCellHighlight     color.Color
 }
 
 // epokColorScheme provides the colors used is specific components of epok.
@@ -185,6 +186,7 @@ func (t *Theme) epokColorScheme() scheme {
 		TableBorder:            t.Primary,
 		TableCellText:          c(t.LightTheme.Text, t.DarkTheme.Text),
 		TableCellTextAlternate: c(t.LightTheme.TextSubdued, t.DarkTheme.TextSubdued),
+		TableCellHighlight:     c(t.LightTheme.Accent, t.DarkTheme.Accent),
 	}
 }
 
@@ -205,6 +207,8 @@ type TableStyle struct {
 	Cell            lipgloss.Style
 	OddRow          lipgloss.Style
 	EvenRow         lipgloss.Style
+	Highlight       lipgloss.Style // Highlight marks cells that stand out, like working hours.
+	Selected        lipgloss.Style // Selected marks the cells that are chosen, like a meeting slot.
 	Border          lipgloss.Style
 	BorderThickness lipgloss.Border
 }
@@ -231,6 +235,8 @@ func (t *Theme) Sheet() *Sheet {
 			Cell:            baseCellStyle,
 			OddRow:          baseCellStyle.Foreground(es.TableCellTextAlternate),
 			EvenRow:         baseCellStyle.Foreground(es.TableCellText),
+			Highlight:       baseCellStyle.Foreground(es.TableCellHighlight).Bold(true),
+			Selected:        baseCellStyle.Foreground(es.TableCellHighlight).Bold(true).Reverse(true),
 			Border:          lipgloss.NewStyle().Foreground(es.TableBorder),
 			BorderThickness: lipgloss.ThickBorder(),
 		},
@@ -0,0 +1,10 @@
+// Package leapsecond is a module for converting between UTC and the atomic time scales, like TAI and
+// GPS, with an embedded table of leap seconds.
+//
+// TAI instants are represented as time.Time values that count TAI seconds on the Unix scale, i.e. a TAI
+// clock reading of 2017-01-01 00:00:37 is the UTC instant 2017-01-01 00:00:00. This is the convention
+// used by PTP and the Linux CLOCK_TAI clock.
+//
+// The table starts in 1972, when UTC adopted leap seconds. Earlier instants use the 1972 offset of 10
+// seconds.
+package leapsecond
@@ -0,0 +1,124 @@
+package leapsecond
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ntpOffset is the number of seconds from 1900-01-01, the origin of the table, to the Unix epoch.
+const ntpOffset = 2_208_988_800
+
+// GPSOffset is TAI-GPS in seconds. GPS time matched UTC at its epoch in 1980, and doesn't apply leap seconds.
+const GPSOffset = 19
+
+//go:embed leap-seconds.list
+var list string
+
+// Entry is a TAI-UTC offset and the UTC instant it takes effect. The leap second is inserted
+// just before Start.
+type Entry struct {
+	Start  time.Time
+	Offset int
+}
+
+var (
+	table   []Entry
+	expires time.Time
+)
+
+func init() {
+	var err error
+	table, expires, err = parseList(list)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded leap second table: %v", err))
+	}
+}
+
+// Table returns the leap second table, ordered by start.
+func Table() []Entry {
+	return append([]Entry(nil), table...)
+}
+
+// Expires returns when the embedded table stops being authoritative. A new leap second could be
+// announced for any time after it.
+func Expires() time.Time {
+	return expires
+}
+
+// Offset returns TAI-UTC in seconds at the UTC instant t.
+func Offset(t time.Time) int {
+	offset := table[0].Offset
+	for _, e := range table {
+		if t.Before(e.Start) {
+			break
+		}
+		offset = e.Offset
+	}
+	return offset
+}
+
+// ToTAI converts a UTC instant to TAI.
+func ToTAI(t time.Time) time.Time {
+	return t.Add(time.Duration(Offset(t)) * time.Second)
+}
+
+// FromTAI converts a TAI instant to UTC. Since time.Time can't represent 23:59:60, the leap second
+// itself is read as a repeat of 23:59:59, like the kernel clock.
+func FromTAI(tai time.Time) time.Time {
+	offset := table[0].Offset
+	for _, e := range table {
+		// The leap second starts one second before the new offset applies in UTC.
+		leap := e.Start.Add(time.Duration(e.Offset-1) * time.Second)
+		if tai.Before(leap) {
+			break
+		}
+		offset = e.Offset
+	}
+	return tai.Add(-time.Duration(offset) * time.Second)
+}
+
+// parseList reads a leap-seconds.list file from IERS or NIST.
+func parseList(s string) ([]Entry, time.Time, error) {
+	var entries []Entry
+	var expiry time.Time
+
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if rest, found := strings.CutPrefix(line, "#@"); found {
+			seconds, err := strconv.ParseInt(strings.TrimSpace(rest), 10, 64)
+			if err != nil {
+				return nil, time.Time{}, fmt.Errorf("invalid expiry %q: %w", line, err)
+			}
+			expiry = time.Unix(seconds-ntpOffset, 0).UTC()
+			continue
+		}
+
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, time.Time{}, fmt.Errorf("invalid entry %q", line)
+		}
+		seconds, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("invalid entry %q: %w", line, err)
+		}
+		offset, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("invalid entry %q: %w", line, err)
+		}
+		entries = append(entries, Entry{Start: time.Unix(seconds-ntpOffset, 0).UTC(), Offset: offset})
+	}
+	if len(entries) == 0 {
+		return nil, time.Time{}, errors.New("no entries")
+	}
+	return entries, expiry, scanner.Err()
+}
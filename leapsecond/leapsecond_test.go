@@ -0,0 +1,99 @@
+package leapsecond
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOffset(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    time.Time
+		expected int
+	}{
+		{
+			name:     "before the table",
+			input:    time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: 10,
+		},
+		{
+			name:     "gps epoch",
+			input:    time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC),
+			expected: 19,
+		},
+		{
+			name:     "last second before a leap",
+			input:    time.Date(2016, time.December, 31, 23, 59, 59, 999_999_999, time.UTC),
+			expected: 36,
+		},
+		{
+			name:     "after the latest leap",
+			input:    time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC),
+			expected: 37,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Offset(tt.input); result != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFromTAI(t *testing.T) {
+	// 2016-12-31 23:59:59 UTC is 2017-01-01 00:00:35 TAI, and the leap second follows it.
+	before := time.Date(2016, time.December, 31, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		tai      time.Time
+		expected time.Time
+	}{
+		{
+			name:     "before the leap second",
+			tai:      time.Date(2017, time.January, 1, 0, 0, 35, 0, time.UTC),
+			expected: before,
+		},
+		{
+			name:     "the leap second repeats 23:59:59",
+			tai:      time.Date(2017, time.January, 1, 0, 0, 36, 500_000_000, time.UTC),
+			expected: before.Add(500 * time.Millisecond),
+		},
+		{
+			name:     "after the leap second",
+			tai:      time.Date(2017, time.January, 1, 0, 0, 37, 0, time.UTC),
+			expected: time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := FromTAI(tt.tai); !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestTable(t *testing.T) {
+	entries := Table()
+	if len(entries) != 28 {
+		t.Fatalf("expected 28 entries, got %d", len(entries))
+	}
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Offset != entries[i-1].Offset+1 || !entries[i].Start.After(entries[i-1].Start) {
+			t.Errorf("entry %v doesn't follow %v", entries[i], entries[i-1])
+		}
+	}
+	if !Expires().After(entries[len(entries)-1].Start) {
+		t.Errorf("expected expiry after the last entry, got %v", Expires())
+	}
+
+	for _, e := range entries {
+		if roundTrip := FromTAI(ToTAI(e.Start)); !roundTrip.Equal(e.Start) {
+			t.Errorf("expected %v, got %v", e.Start, roundTrip)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package natural
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Span is the difference between two instants, broken down into calendar units. Every field has the
+// sign of the difference.
+type Span struct {
+	Years       int
+	Months      int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// Between breaks the difference from a to b down into years, months and days in loc, followed by the
+// elapsed hours, minutes and seconds. The calendar units step like Add, so a day across a daylight
+// saving transition can be 23 or 25 hours long, and leap days are counted.
+func Between(a, b time.Time, loc *time.Location) Span {
+	if b.Before(a) {
+		return Between(b, a, loc).neg()
+	}
+	a, b = a.In(loc), b.In(loc)
+
+	// Start from the difference in calendar months and step back, since adding months to the end of
+	// a month can overflow into the next one.
+	months := (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+	for months > 0 && Add(a, months, Month).After(b) {
+		months--
+	}
+	cursor := Add(a, months, Month)
+
+	days := int(b.Sub(cursor) / (24 * time.Hour))
+	for days > 0 && Add(cursor, days, Day).After(b) {
+		days--
+	}
+	for !Add(cursor, days+1, Day).After(b) {
+		days++
+	}
+	cursor = Add(cursor, days, Day)
+
+	rest := b.Sub(cursor)
+	return Span{
+		Years:       months / 12,
+		Months:      months % 12,
+		Days:        days,
+		Hours:       int(rest / time.Hour),
+		Minutes:     int(rest % time.Hour / time.Minute),
+		Seconds:     int(rest % time.Minute / time.Second),
+		Nanoseconds: int(rest % time.Second),
+	}
+}
+
+// String writes the span like "1 year, 2 months, 3 days, 4 hours, 5 minutes, 6.5 seconds", leaving out
+// the units that are zero. A negative span starts with a minus sign.
+func (s Span) String() string {
+	sign := ""
+	if s.Years < 0 || s.Months < 0 || s.Days < 0 || s.Hours < 0 || s.Minutes < 0 || s.Seconds < 0 || s.Nanoseconds < 0 {
+		sign = "-"
+	}
+
+	var parts []string
+	for _, part := range []struct {
+		n    int
+		unit Unit
+	}{
+		{n: s.Years, unit: Year},
+		{n: s.Months, unit: Month},
+		{n: s.Days, unit: Day},
+		{n: s.Hours, unit: Hour},
+		{n: s.Minutes, unit: Minute},
+	} {
+		if part.n != 0 {
+			parts = append(parts, plural(abs(part.n), part.unit.String()))
+		}
+	}
+
+	if s.Seconds != 0 || s.Nanoseconds != 0 || len(parts) == 0 {
+		seconds := fmt.Sprintf("%d", abs(s.Seconds))
+		if s.Nanoseconds != 0 {
+			seconds = strings.TrimRight(fmt.Sprintf("%d.%09d", abs(s.Seconds), abs(s.Nanoseconds)), "0")
+		}
+		if seconds == "1" {
+			parts = append(parts, "1 second")
+		} else {
+			parts = append(parts, seconds+" seconds")
+		}
+	}
+	return sign + strings.Join(parts, ", ")
+}
+
+// BusinessDays counts the weekdays, Monday to Friday, from the date of a up to but not including the
+// date of b in loc. It is negative when b is before a. Holidays aren't known, so they are counted.
+func BusinessDays(a, b time.Time, loc *time.Location) int {
+	if b.Before(a) {
+		return -BusinessDays(b, a, loc)
+	}
+
+	// Count in UTC dates, so every day is 24 hours long.
+	ay, am, ad := a.In(loc).Date()
+	by, bm, bd := b.In(loc).Date()
+	start := time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)
+	end := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+
+	days := int((end.Unix() - start.Unix()) / (24 * 60 * 60))
+	count := days / 7 * 5
+	for day := start.AddDate(0, 0, days/7*7); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			count++
+		}
+	}
+	return count
+}
+
+// neg flips the sign of every unit in the span.
+func (s Span) neg() Span {
+	return Span{
+		Years:       -s.Years,
+		Months:      -s.Months,
+		Days:        -s.Days,
+		Hours:       -s.Hours,
+		Minutes:     -s.Minutes,
+		Seconds:     -s.Seconds,
+		Nanoseconds: -s.Nanoseconds,
+	}
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
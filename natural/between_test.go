@@ -0,0 +1,117 @@
+package natural
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBetween(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		a        time.Time
+		b        time.Time
+		loc      *time.Location
+		expected Span
+		str      string
+	}{
+		{
+			name:     "mixed units",
+			a:        time.Date(2024, time.January, 15, 9, 0, 0, 0, time.UTC),
+			b:        time.Date(2025, time.March, 18, 13, 30, 5, 500_000_000, time.UTC),
+			loc:      time.UTC,
+			expected: Span{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 30, Seconds: 5, Nanoseconds: 500_000_000},
+			str:      "1 year, 2 months, 3 days, 4 hours, 30 minutes, 5.5 seconds",
+		},
+		{
+			name:     "reversed",
+			a:        time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC),
+			b:        time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+			loc:      time.UTC,
+			expected: Span{Days: -1},
+			str:      "-1 day",
+		},
+		{
+			name:     "leap day",
+			a:        time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC),
+			b:        time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			loc:      time.UTC,
+			expected: Span{Days: 2},
+			str:      "2 days",
+		},
+		{
+			name:     "end of month",
+			a:        time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+			b:        time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			loc:      time.UTC,
+			expected: Span{Days: 29},
+			str:      "29 days",
+		},
+		{
+			name:     "a day across spring forward is 23 hours",
+			a:        time.Date(2025, time.March, 8, 12, 0, 0, 0, newYork),
+			b:        time.Date(2025, time.March, 9, 12, 0, 0, 0, newYork),
+			loc:      newYork,
+			expected: Span{Days: 1},
+			str:      "1 day",
+		},
+		{
+			name:     "the same instants in UTC",
+			a:        time.Date(2025, time.March, 8, 12, 0, 0, 0, newYork),
+			b:        time.Date(2025, time.March, 9, 12, 0, 0, 0, newYork),
+			loc:      time.UTC,
+			expected: Span{Hours: 23},
+			str:      "23 hours",
+		},
+		{
+			name:     "no difference",
+			a:        time.Date(2025, time.March, 8, 12, 0, 0, 0, time.UTC),
+			b:        time.Date(2025, time.March, 8, 12, 0, 0, 0, time.UTC),
+			loc:      time.UTC,
+			expected: Span{},
+			str:      "0 seconds",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Between(tt.a, tt.b, tt.loc)
+			if result != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, result)
+			}
+			if result.String() != tt.str {
+				t.Errorf("expected %q, got %q", tt.str, result.String())
+			}
+		})
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	// 2025-06-27 is a Friday.
+	friday := time.Date(2025, time.June, 27, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		a        time.Time
+		b        time.Time
+		expected int
+	}{
+		{name: "same day", a: friday, b: friday.Add(time.Hour), expected: 0},
+		{name: "over the weekend", a: friday, b: friday.AddDate(0, 0, 3), expected: 1},
+		{name: "two weeks", a: friday, b: friday.AddDate(0, 0, 14), expected: 10},
+		{name: "from a saturday", a: friday.AddDate(0, 0, 1), b: friday.AddDate(0, 0, 10), expected: 5},
+		{name: "reversed", a: friday.AddDate(0, 0, 3), b: friday, expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := BusinessDays(tt.a, tt.b, time.UTC); result != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, result)
+			}
+		})
+	}
+}
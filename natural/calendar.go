@@ -0,0 +1,191 @@
+package natural
+
+import (
+	"strings"
+	"time"
+)
+
+// Unit is a span of time used in expressions. Units of a day or longer follow the calendar in the
+// target time zone, so adding a day keeps the wall-clock time across daylight saving transitions.
+type Unit int
+
+const (
+	Nanosecond Unit = iota + 1
+	Microsecond
+	Millisecond
+	Second
+	Minute
+	Hour
+	Day
+	Week
+	Month
+	Quarter
+	Year
+)
+
+var unitNames = map[string]Unit{
+	"ns": Nanosecond, "nanosecond": Nanosecond, "nanoseconds": Nanosecond,
+	"us": Microsecond, "µs": Microsecond, "microsecond": Microsecond, "microseconds": Microsecond,
+	"ms": Millisecond, "millisecond": Millisecond, "milliseconds": Millisecond,
+	"s": Second, "sec": Second, "secs": Second, "second": Second, "seconds": Second,
+	"m": Minute, "min": Minute, "mins": Minute, "minute": Minute, "minutes": Minute,
+	"h": Hour, "hr": Hour, "hrs": Hour, "hour": Hour, "hours": Hour,
+	"d": Day, "day": Day, "days": Day,
+	"w": Week, "wk": Week, "wks": Week, "week": Week, "weeks": Week,
+	"mo": Month, "mos": Month, "month": Month, "months": Month,
+	"q": Quarter, "quarter": Quarter, "quarters": Quarter,
+	"y": Year, "yr": Year, "yrs": Year, "year": Year, "years": Year,
+}
+
+// ParseUnit reads the name of a unit, like "days", "hr" or "q".
+func ParseUnit(s string) (Unit, bool) {
+	u, ok := unitNames[strings.ToLower(s)]
+	return u, ok
+}
+
+// String returns the singular name of the unit.
+func (u Unit) String() string {
+	switch u {
+	case Nanosecond:
+		return "nanosecond"
+	case Microsecond:
+		return "microsecond"
+	case Millisecond:
+		return "millisecond"
+	case Second:
+		return "second"
+	case Minute:
+		return "minute"
+	case Hour:
+		return "hour"
+	case Day:
+		return "day"
+	case Week:
+		return "week"
+	case Month:
+		return "month"
+	case Quarter:
+		return "quarter"
+	case Year:
+		return "year"
+	default:
+		return "unknown"
+	}
+}
+
+// isCalendar reports whether the unit follows the calendar rather than elapsed time.
+func (u Unit) isCalendar() bool {
+	return u >= Day
+}
+
+// duration returns the elapsed time of one clock unit.
+func (u Unit) duration() time.Duration {
+	switch u {
+	case Nanosecond:
+		return time.Nanosecond
+	case Microsecond:
+		return time.Microsecond
+	case Millisecond:
+		return time.Millisecond
+	case Second:
+		return time.Second
+	case Minute:
+		return time.Minute
+	default:
+		return time.Hour
+	}
+}
+
+// Add adds n units to t. Clock units (hours and smaller) add elapsed time. Calendar units keep the
+// wall-clock time in t's location, like time.AddDate, but with the daylight saving rules of Wall.
+func Add(t time.Time, n int, u Unit) time.Time {
+	if !u.isCalendar() {
+		return t.Add(time.Duration(n) * u.duration())
+	}
+
+	var years, months, days int
+	switch u {
+	case Day:
+		days = n
+	case Week:
+		days = 7 * n
+	case Month:
+		months = n
+	case Quarter:
+		months = 3 * n
+	case Year:
+		years = n
+	}
+
+	y, m, d := t.Date()
+	return Wall(y+years, m+time.Month(months), d+days, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// StartOf returns the first instant of the unit that contains t, in t's location.
+// Weeks start on Monday, following ISO 8601.
+func StartOf(t time.Time, u Unit) time.Time {
+	switch u {
+	case Hour:
+		// Step back in elapsed time, so an hour repeated by a daylight saving transition stays distinct,
+		// and zones with fractional offsets start on their own hour.
+		sinceHour := time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+		return t.Add(-sinceHour - time.Duration(t.Nanosecond()))
+	case Nanosecond, Microsecond, Millisecond, Second, Minute:
+		return t.Truncate(u.duration())
+	}
+
+	y, m, d := t.Date()
+	loc := t.Location()
+	switch u {
+	case Day:
+		return Wall(y, m, d, 0, 0, 0, 0, loc)
+	case Week:
+		sinceMonday := (int(t.Weekday()) + 6) % 7
+		return Wall(y, m, d-sinceMonday, 0, 0, 0, 0, loc)
+	case Month:
+		return Wall(y, m, 1, 0, 0, 0, 0, loc)
+	case Quarter:
+		return Wall(y, m-(m-1)%3, 1, 0, 0, 0, 0, loc)
+	default:
+		return Wall(y, time.January, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// EndOf returns the last instant (to the nanosecond) of the unit that contains t, in t's location.
+func EndOf(t time.Time, u Unit) time.Time {
+	start := StartOf(t, u)
+	if u == Hour {
+		// An hour can be repeated by a daylight saving transition, so step in elapsed time.
+		return start.Add(time.Hour - time.Nanosecond)
+	}
+	return Add(start, 1, u).Add(-time.Nanosecond)
+}
+
+// Wall returns the instant with the given wall-clock time in loc, like time.Date, but with predictable
+// daylight saving rules. A wall-clock time that is skipped by a transition is moved forward by the
+// length of the gap (02:30 becomes 03:30 when clocks spring forward at 02:00), and a wall-clock time
+// that happens twice resolves to the earlier instant.
+func Wall(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) time.Time {
+	// Treat the wall-clock time as UTC, then try the offsets in effect on either side of it.
+	wall := time.Date(year, month, day, hour, min, sec, nsec, time.UTC)
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	var valid []time.Time
+	for _, offset := range []int{before, after} {
+		candidate := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if _, actual := candidate.Zone(); actual == offset {
+			valid = append(valid, candidate)
+		}
+	}
+
+	switch {
+	case len(valid) == 2 && valid[1].Before(valid[0]):
+		return valid[1]
+	case len(valid) > 0:
+		return valid[0]
+	default:
+		// The wall-clock time was skipped. Using the earlier offset moves it forward by the gap.
+		return wall.Add(-time.Duration(before) * time.Second).In(loc)
+	}
+}
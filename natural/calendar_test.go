@@ -0,0 +1,170 @@
+package natural
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWall(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		wall     time.Time // wall is read as a wall-clock time in newYork
+		expected time.Time
+	}{
+		{
+			name:     "regular time",
+			wall:     time.Date(2025, time.June, 28, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, time.June, 28, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "skipped by spring forward",
+			wall:     time.Date(2025, time.March, 9, 2, 30, 0, 0, time.UTC),
+			expected: time.Date(2025, time.March, 9, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "repeated by fall back",
+			wall:     time.Date(2025, time.November, 2, 1, 30, 0, 0, time.UTC),
+			expected: time.Date(2025, time.November, 2, 5, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "just after fall back",
+			wall:     time.Date(2025, time.November, 2, 2, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, time.November, 2, 7, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := tt.wall
+			result := Wall(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), w.Nanosecond(), newYork)
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestStartAndEndOf(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		input time.Time
+		unit  Unit
+		start time.Time
+		end   time.Time
+	}{
+		{
+			name:  "day with a DST gap is 23 hours",
+			input: time.Date(2025, time.March, 9, 12, 0, 0, 0, newYork),
+			unit:  Day,
+			start: time.Date(2025, time.March, 9, 0, 0, 0, 0, newYork),
+			end:   time.Date(2025, time.March, 9, 0, 0, 0, 0, newYork).Add(23*time.Hour - time.Nanosecond),
+		},
+		{
+			name:  "repeated hour",
+			input: time.Date(2025, time.November, 2, 6, 45, 0, 0, time.UTC), // 01:45 EST, the second one
+			unit:  Hour,
+			start: time.Date(2025, time.November, 2, 6, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, time.November, 2, 7, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+		{
+			name:  "hour with a fractional offset",
+			input: time.Date(2025, time.June, 28, 9, 45, 0, 0, kolkata),
+			unit:  Hour,
+			start: time.Date(2025, time.June, 28, 9, 0, 0, 0, kolkata),
+			end:   time.Date(2025, time.June, 28, 10, 0, 0, 0, kolkata).Add(-time.Nanosecond),
+		},
+		{
+			name:  "week starts on monday",
+			input: time.Date(2025, time.June, 29, 12, 0, 0, 0, time.UTC), // Sunday
+			unit:  Week,
+			start: time.Date(2025, time.June, 23, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+		{
+			name:  "quarter",
+			input: time.Date(2025, time.August, 15, 0, 0, 0, 0, time.UTC),
+			unit:  Quarter,
+			start: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+		{
+			name:  "leap year february",
+			input: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC),
+			unit:  Month,
+			start: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+		{
+			name:  "minute",
+			input: time.Date(2024, time.February, 10, 0, 0, 30, 5, time.UTC),
+			unit:  Minute,
+			start: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, time.February, 10, 0, 1, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if start := StartOf(tt.input, tt.unit); !start.Equal(tt.start) {
+				t.Errorf("expected start %v, got %v", tt.start, start)
+			}
+			if end := EndOf(tt.input, tt.unit); !end.Equal(tt.end) {
+				t.Errorf("expected end %v, got %v", tt.end, end)
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    time.Time
+		n        int
+		unit     Unit
+		expected time.Time
+	}{
+		{
+			name:     "months overflow like time.AddDate",
+			input:    time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+			n:        1,
+			unit:     Month,
+			expected: time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "quarters",
+			input:    time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC),
+			n:        -2,
+			unit:     Quarter,
+			expected: time.Date(2024, time.July, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "milliseconds",
+			input:    time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC),
+			n:        1500,
+			unit:     Millisecond,
+			expected: time.Date(2025, time.January, 15, 0, 0, 1, 500_000_000, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Add(tt.input, tt.n, tt.unit); !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Package natural is a module for resolving natural-language and relative date expressions, like
+// "3 days ago", "next monday 09:00" or "end of quarter", into time.Time objects.
+//
+// Expressions are always resolved against an injectable reference time and follow calendar rules in the
+// target time zone. Wall-clock times that are skipped by a daylight saving transition are moved forward
+// by the length of the gap, and wall-clock times that happen twice resolve to the earlier instant.
+package natural
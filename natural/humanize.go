@@ -0,0 +1,194 @@
+package natural
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HumanizeOptions control how Humanize describes the time between two instants.
+type HumanizeOptions struct {
+	// Units is the number of units shown, counting from the largest one that isn't zero. The default is 2,
+	// e.g. "6 years, 3 months ago".
+	Units int
+
+	// Round rounds to the nearest last unit shown, rather than truncating the smaller units.
+	Round bool
+
+	// Location is the calendar the years, months and days are counted in. The default is UTC.
+	Location *time.Location
+}
+
+// spanUnits are the units of a Span shown by Humanize, from largest to smallest.
+var spanUnits = []Unit{Year, Month, Day, Hour, Minute, Second}
+
+// Humanize describes when t is relative to now, like "6 years, 3 months ago" or "2 days, 4 hours from now".
+// Differences below a second are "now".
+func Humanize(t, now time.Time, opts HumanizeOptions) string {
+	span := Approximate(t, now, opts)
+
+	var parts []string
+	for _, u := range spanUnits {
+		if n := span.get(u); n != 0 {
+			parts = append(parts, plural(abs(n), u.String()))
+		}
+	}
+	switch {
+	case len(parts) == 0:
+		return "now"
+	case t.After(now):
+		return strings.Join(parts, ", ") + " from now"
+	default:
+		return strings.Join(parts, ", ") + " ago"
+	}
+}
+
+// Approximate returns the span from now to t that Humanize describes, with only the units it shows. It
+// is negative when t is before now.
+func Approximate(t, now time.Time, opts HumanizeOptions) Span {
+	units := opts.Units
+	if units <= 0 {
+		units = 2
+	}
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	from, to := t, now
+	if t.After(now) {
+		from, to = now, t
+	}
+
+	span := Between(from, to, loc)
+	shown := span.largest(units)
+	if opts.Round && len(shown) > 0 {
+		// Round up when the rest is at least half of the last unit, and let Between carry it over,
+		// e.g. 11 months and 20 days becomes 1 year.
+		last := shown[len(shown)-1]
+		start := span.truncate(from, last, loc)
+		next := Add(start, 1, last)
+		if to.Sub(start) >= next.Sub(to) {
+			span = Between(from, next, loc)
+			shown = span.largest(units)
+		}
+	}
+
+	var approx Span
+	for _, u := range shown {
+		approx.set(u, span.get(u))
+	}
+	if t.Before(now) {
+		return approx.neg()
+	}
+	return approx
+}
+
+// largest returns up to n units, starting from the largest one that isn't zero.
+func (s Span) largest(n int) []Unit {
+	for i, u := range spanUnits {
+		if s.get(u) != 0 {
+			return spanUnits[i:min(i+n, len(spanUnits))]
+		}
+	}
+	return nil
+}
+
+// get returns the amount of a unit in the span.
+func (s Span) get(u Unit) int {
+	switch u {
+	case Year:
+		return s.Years
+	case Month:
+		return s.Months
+	case Day:
+		return s.Days
+	case Hour:
+		return s.Hours
+	case Minute:
+		return s.Minutes
+	case Second:
+		return s.Seconds
+	default:
+		return 0
+	}
+}
+
+// set sets the amount of a unit in the span.
+func (s *Span) set(u Unit, n int) {
+	switch u {
+	case Year:
+		s.Years = n
+	case Month:
+		s.Months = n
+	case Day:
+		s.Days = n
+	case Hour:
+		s.Hours = n
+	case Minute:
+		s.Minutes = n
+	case Second:
+		s.Seconds = n
+	}
+}
+
+// truncate adds the span to from in loc, leaving out the units smaller than last. It steps like Between,
+// adding the months first, then the days and then the elapsed time.
+func (s Span) truncate(from time.Time, last Unit, loc *time.Location) time.Time {
+	months := 12 * s.Years
+	if last <= Month {
+		months += s.Months
+	}
+	t := Add(from.In(loc), months, Month)
+	if last <= Day {
+		t = Add(t, s.Days, Day)
+	}
+	for _, u := range []Unit{Hour, Minute, Second} {
+		if last <= u {
+			t = Add(t, s.get(u), u)
+		}
+	}
+	return t
+}
+
+// ISO8601 writes the span as an ISO 8601 duration, like "P6Y3M12DT4H5M6.5S", with a leading minus sign
+// when it's negative.
+func (s Span) ISO8601() string {
+	if s == (Span{}) {
+		return "PT0S"
+	}
+
+	var sb strings.Builder
+	if s.Years < 0 || s.Months < 0 || s.Days < 0 || s.Hours < 0 || s.Minutes < 0 || s.Seconds < 0 || s.Nanoseconds < 0 {
+		sb.WriteString("-")
+	}
+	sb.WriteString("P")
+	for _, part := range []struct {
+		n          int
+		designator string
+	}{{s.Years, "Y"}, {s.Months, "M"}, {s.Days, "D"}} {
+		if part.n != 0 {
+			fmt.Fprintf(&sb, "%d%s", abs(part.n), part.designator)
+		}
+	}
+
+	if s.Hours == 0 && s.Minutes == 0 && s.Seconds == 0 && s.Nanoseconds == 0 {
+		return sb.String()
+	}
+
+	sb.WriteString("T")
+	if s.Hours != 0 {
+		fmt.Fprintf(&sb, "%dH", abs(s.Hours))
+	}
+	if s.Minutes != 0 {
+		fmt.Fprintf(&sb, "%dM", abs(s.Minutes))
+	}
+	if s.Seconds != 0 || s.Nanoseconds != 0 {
+		seconds := fmt.Sprintf("%d", abs(s.Seconds))
+		if s.Nanoseconds != 0 {
+			seconds = strings.TrimRight(fmt.Sprintf("%d.%09d", abs(s.Seconds), abs(s.Nanoseconds)), "0")
+		}
+		sb.WriteString(seconds + "S")
+	}
+	return sb.String()
+}
@@ -0,0 +1,163 @@
+package natural
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanize(t *testing.T) {
+	now := time.Date(2025, time.June, 28, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		t        time.Time
+		opts     HumanizeOptions
+		expected string
+	}{
+		{
+			name:     "two units by default",
+			t:        time.Date(2019, time.March, 20, 10, 0, 0, 0, time.UTC),
+			expected: "6 years, 3 months ago",
+		},
+		{
+			name:     "more units",
+			t:        time.Date(2019, time.March, 20, 10, 0, 0, 0, time.UTC),
+			opts:     HumanizeOptions{Units: 4},
+			expected: "6 years, 3 months, 8 days, 2 hours ago",
+		},
+		{
+			name:     "future",
+			t:        now.Add(52*time.Hour + 30*time.Minute),
+			expected: "2 days, 4 hours from now",
+		},
+		{
+			name:     "singular",
+			t:        now.Add(24 * time.Hour),
+			expected: "1 day from now",
+		},
+		{
+			name:     "zero units are hidden",
+			t:        time.Date(2024, time.June, 28, 11, 55, 0, 0, time.UTC),
+			expected: "1 year ago",
+		},
+		{
+			name:     "less than a second",
+			t:        now.Add(-500 * time.Millisecond),
+			expected: "now",
+		},
+		{
+			name:     "truncated by default",
+			t:        time.Date(2024, time.July, 8, 12, 0, 0, 0, time.UTC),
+			opts:     HumanizeOptions{Units: 1},
+			expected: "11 months ago",
+		},
+		{
+			name:     "rounding carries over",
+			t:        time.Date(2024, time.July, 8, 12, 0, 0, 0, time.UTC),
+			opts:     HumanizeOptions{Units: 1, Round: true},
+			expected: "1 year ago",
+		},
+		{
+			name:     "rounding down",
+			t:        now.Add(-89 * time.Minute),
+			opts:     HumanizeOptions{Units: 1, Round: true},
+			expected: "1 hour ago",
+		},
+		{
+			name:     "rounding the last of two units",
+			t:        now.Add(-(89*time.Minute + 30*time.Second)),
+			opts:     HumanizeOptions{Round: true},
+			expected: "1 hour, 30 minutes ago",
+		},
+		{
+			// May 31 is a month ago in UTC, but June 1 is less than one in UTC+5.
+			name:     "calendar of the location",
+			t:        time.Date(2025, time.May, 31, 22, 0, 0, 0, time.UTC),
+			opts:     HumanizeOptions{Location: time.FixedZone("UTC+5", 5*60*60)},
+			expected: "27 days, 14 hours ago",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := Humanize(tt.t, now, tt.opts)
+			if actual != tt.expected {
+				t.Errorf("Humanize() = %q, expected %q", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSpanISO8601(t *testing.T) {
+	tests := []struct {
+		name     string
+		span     Span
+		expected string
+	}{
+		{
+			name:     "every unit",
+			span:     Span{Years: 6, Months: 3, Days: 12, Hours: 4, Minutes: 5, Seconds: 6, Nanoseconds: 500_000_000},
+			expected: "P6Y3M12DT4H5M6.5S",
+		},
+		{
+			name:     "negative",
+			span:     Span{Days: -1},
+			expected: "-P1D",
+		},
+		{
+			name:     "time only",
+			span:     Span{Hours: 1},
+			expected: "PT1H",
+		},
+		{
+			name:     "fraction of a second",
+			span:     Span{Nanoseconds: -1_000},
+			expected: "-PT0.000001S",
+		},
+		{
+			name:     "zero",
+			expected: "PT0S",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.span.ISO8601()
+			if actual != tt.expected {
+				t.Errorf("ISO8601() = %q, expected %q", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestApproximate(t *testing.T) {
+	now := time.Date(2025, time.June, 28, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		t        time.Time
+		opts     HumanizeOptions
+		expected Span
+	}{
+		{
+			name:     "past is negative",
+			t:        time.Date(2019, time.March, 20, 10, 0, 0, 0, time.UTC),
+			expected: Span{Years: -6, Months: -3},
+		},
+		{
+			name:     "future",
+			t:        now.Add(52*time.Hour + 30*time.Minute + 500*time.Millisecond),
+			opts:     HumanizeOptions{Units: 3},
+			expected: Span{Days: 2, Hours: 4, Minutes: 30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := Approximate(tt.t, now, tt.opts)
+			if actual != tt.expected {
+				t.Errorf("Approximate() = %+v, expected %+v", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,440 @@
+package natural
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DanStough/epok/parse"
+)
+
+var ErrInvalidExpression = errors.New("invalid expression")
+
+// Options control how expressions are resolved by Parse.
+type Options struct {
+	// Now is the reference time for relative expressions. The default is the current time.
+	Now time.Time
+
+	// Location is the time zone used for calendar rules, unless the expression names its own
+	// with "in <zone>". The default is `Local`.
+	Location *time.Location
+
+	// LoadZone loads the zones named in expressions, so callers can accept more than IANA names, like
+	// "in tokyo" or "in +05:30", or load them from another database. The default only accepts IANA names.
+	LoadZone func(name string) (*time.Location, error)
+}
+
+var (
+	// clock matches times of day like "9", "09:00", "9:30pm" or "17:45:10.5".
+	clock = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(?::(\d{2})(?:\.(\d{1,9}))?)?(am|pm)?$`)
+
+	// compact matches amounts with an attached unit, like "3d", "-2h" or "+90min".
+	compact = regexp.MustCompile(`^([+-]?\d+)([a-zµ]+)$`)
+
+	// isoDate matches calendar dates that anchor an expression, like "2025-06-28".
+	isoDate = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+var numberWords = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+	"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// fillers are words that read naturally but don't change the meaning of an expression.
+var fillers = map[string]bool{
+	"at": true, "on": true, "and": true, "the": true, "of": true,
+}
+
+// Parse resolves a natural-language or relative expression into a time.Time. It understands:
+//   - anchors: "now", "today", "tomorrow", "yesterday" and dates like "2025-06-28"
+//   - weekdays: "monday", "next friday", "last tue"
+//   - times of day: "09:00", "9:30pm", "noon", "midnight"
+//   - offsets: "3 days ago", "in 2 hours", "an hour from now", "+90min", "-1w"
+//   - periods: "next week", "last month", "start of month", "end of next quarter"
+//   - time zones: "in Asia/Tokyo", "UTC"
+//
+// Terms are applied from left to right, e.g. "tomorrow noon in Asia/Tokyo". The result is set
+// in the time zone of the expression.
+func Parse(s string, opts Options) (time.Time, error) {
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	loc := opts.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	raw := strings.Fields(strings.ReplaceAll(s, ",", " "))
+	raw, loc = extractZone(raw, loc, opts.LoadZone)
+	if len(raw) == 0 {
+		return time.Time{}, fmt.Errorf("%w: empty", ErrInvalidExpression)
+	}
+
+	p := &parser{
+		raw:   raw,
+		words: make([]string, len(raw)),
+		t:     now.In(loc),
+		now:   now,
+	}
+	for i, r := range raw {
+		p.words[i] = strings.ToLower(r)
+	}
+
+	for p.pos < len(p.words) {
+		if err := p.term(); err != nil {
+			return time.Time{}, err
+		}
+	}
+	return p.t, nil
+}
+
+// extractZone removes a time zone from the expression, either "in <zone>" or a bare zone name
+// like "UTC" or "Asia/Tokyo". Zones are loaded with load first, when it's set.
+func extractZone(raw []string, loc *time.Location, load func(string) (*time.Location, error)) ([]string, *time.Location) {
+	for i := 0; i < len(raw); i++ {
+		word := raw[i]
+		if strings.EqualFold(word, "in") && i+1 < len(raw) {
+			zone, err := loadZone(raw[i+1], load)
+			if _, isNumber := numberWords[strings.ToLower(raw[i+1])]; err != nil && load != nil && !isNumber {
+				zone, err = load(raw[i+1])
+			}
+			if err == nil {
+				return append(raw[:i:i], raw[i+2:]...), zone
+			}
+			continue
+		}
+		if strings.Contains(word, "/") || strings.EqualFold(word, "utc") || strings.EqualFold(word, "gmt") {
+			if zone, err := loadZone(word, load); err == nil {
+				return append(raw[:i:i], raw[i+1:]...), zone
+			}
+		}
+	}
+	return raw, loc
+}
+
+// loadZone loads the keywords for UTC and local time, and IANA names, trying load first when it's set.
+func loadZone(name string, load func(string) (*time.Location, error)) (*time.Location, error) {
+	switch strings.ToLower(name) {
+	case "utc", "gmt", "z":
+		return time.UTC, nil
+	case "local":
+		return time.Local, nil
+	}
+	if _, isNumber := numberWords[strings.ToLower(name)]; isNumber || !strings.Contains(name, "/") {
+		return nil, ErrInvalidExpression
+	}
+	if load != nil {
+		if zone, err := load(name); err == nil {
+			return zone, nil
+		}
+	}
+	return time.LoadLocation(name)
+}
+
+type parser struct {
+	raw   []string // raw are the original tokens, used for error messages and dates.
+	words []string // words are the lowercase tokens.
+	pos   int
+
+	t        time.Time
+	now      time.Time
+	clockSet bool // clockSet is true once the expression names a time of day.
+}
+
+func (p *parser) peek(offset int) string {
+	if p.pos+offset >= len(p.words) {
+		return ""
+	}
+	return p.words[p.pos+offset]
+}
+
+func (p *parser) unexpected() error {
+	if p.pos >= len(p.raw) {
+		return fmt.Errorf("%w: unexpected end", ErrInvalidExpression)
+	}
+	return fmt.Errorf("%w: unexpected %q", ErrInvalidExpression, p.raw[p.pos])
+}
+
+// term consumes and applies the next term of the expression.
+func (p *parser) term() error {
+	word := p.peek(0)
+
+	switch word {
+	case "now":
+		p.pos++
+		return nil
+	case "today":
+		p.pos++
+		p.moveDate(0)
+		return nil
+	case "tomorrow":
+		p.pos++
+		p.moveDate(1)
+		return nil
+	case "yesterday":
+		p.pos++
+		p.moveDate(-1)
+		return nil
+	case "noon", "midday":
+		p.pos++
+		p.setClock(12, 0, 0, 0)
+		return nil
+	case "midnight":
+		p.pos++
+		p.setClock(0, 0, 0, 0)
+		return nil
+	case "next", "last", "this":
+		return p.relativePeriod()
+	case "start", "beginning", "end":
+		return p.boundary()
+	case "in":
+		p.pos++
+		return p.offset(1, true)
+	}
+
+	if fillers[word] {
+		p.pos++
+		return nil
+	}
+	if day, ok := weekdays[word]; ok {
+		p.pos++
+		p.moveWeekday(day, "this")
+		return nil
+	}
+	if m := clock.FindStringSubmatch(word); m != nil && (m[2] != "" || m[5] != "" || isMeridiem(p.peek(1))) {
+		return p.clock(m)
+	}
+	if isoDate.MatchString(word) {
+		return p.date()
+	}
+	if m := compact.FindStringSubmatch(word); m != nil {
+		if unit, ok := ParseUnit(m[2]); ok {
+			n, err := strconv.Atoi(m[1])
+			if err != nil {
+				return p.unexpected()
+			}
+			p.pos++
+			p.t = Add(p.t, n, unit)
+			return nil
+		}
+	}
+	return p.offset(1, false)
+}
+
+// offset consumes an amount and a unit, like "3 days", followed by a direction ("ago", "from now").
+// If the amount is preceded by "in" or has a sign, the direction is optional.
+func (p *parser) offset(sign int, directed bool) error {
+	word := p.peek(0)
+	if strings.HasPrefix(word, "+") || strings.HasPrefix(word, "-") {
+		directed = true
+	}
+
+	n, ok := numberWords[word]
+	if !ok {
+		var err error
+		n, err = strconv.Atoi(word)
+		if err != nil {
+			return p.unexpected()
+		}
+	}
+	p.pos++
+
+	unit, ok := ParseUnit(p.peek(0))
+	if !ok {
+		return p.unexpected()
+	}
+	p.pos++
+
+	switch p.peek(0) {
+	case "ago", "before", "earlier":
+		p.pos++
+		sign = -sign
+	case "later", "after", "hence":
+		p.pos++
+	case "from":
+		if p.peek(1) != "now" {
+			return p.unexpected()
+		}
+		p.pos += 2
+	default:
+		if !directed {
+			return fmt.Errorf("%w: %q needs a direction like \"ago\" or \"from now\"", ErrInvalidExpression, word)
+		}
+	}
+
+	p.t = Add(p.t, sign*n, unit)
+	return nil
+}
+
+// relativePeriod consumes "next", "last" or "this", followed by a weekday or unit.
+func (p *parser) relativePeriod() error {
+	direction := p.peek(0)
+	p.pos++
+
+	if day, ok := weekdays[p.peek(0)]; ok {
+		p.pos++
+		p.moveWeekday(day, direction)
+		return nil
+	}
+
+	unit, ok := ParseUnit(p.peek(0))
+	if !ok {
+		return p.unexpected()
+	}
+	p.pos++
+
+	switch direction {
+	case "next":
+		p.t = Add(p.t, 1, unit)
+	case "last":
+		p.t = Add(p.t, -1, unit)
+	}
+	return nil
+}
+
+// boundary consumes "start of", "beginning of" or "end of", followed by an optional direction and a unit.
+func (p *parser) boundary() error {
+	end := p.peek(0) == "end"
+	p.pos++
+	if p.peek(0) != "of" {
+		return p.unexpected()
+	}
+	p.pos++
+
+	shift := 0
+	switch p.peek(0) {
+	case "next":
+		shift = 1
+		p.pos++
+	case "last", "previous":
+		shift = -1
+		p.pos++
+	case "this", "the":
+		p.pos++
+	}
+
+	unit, ok := ParseUnit(p.peek(0))
+	if !ok {
+		return p.unexpected()
+	}
+	p.pos++
+
+	t := Add(p.t, shift, unit)
+	if end {
+		p.t = EndOf(t, unit)
+	} else {
+		p.t = StartOf(t, unit)
+	}
+	p.clockSet = true
+	return nil
+}
+
+// clock consumes a time of day, like "09:00", "9:30pm" or "9 am".
+func (p *parser) clock(m []string) error {
+	p.pos++
+
+	hour, _ := strconv.Atoi(m[1])
+	minute, _ := strconv.Atoi(m[2])
+	second, _ := strconv.Atoi(m[3])
+	nanos := 0
+	if m[4] != "" {
+		nanos, _ = strconv.Atoi(m[4] + strings.Repeat("0", 9-len(m[4])))
+	}
+
+	meridiem := m[5]
+	if meridiem == "" && isMeridiem(p.peek(0)) {
+		meridiem = p.peek(0)
+		p.pos++
+	}
+	switch meridiem {
+	case "am":
+		if hour < 1 || hour > 12 {
+			return fmt.Errorf("%w: invalid hour %d", ErrInvalidExpression, hour)
+		}
+		hour %= 12
+	case "pm":
+		if hour < 1 || hour > 12 {
+			return fmt.Errorf("%w: invalid hour %d", ErrInvalidExpression, hour)
+		}
+		hour = hour%12 + 12
+	}
+	if hour > 23 || minute > 59 || second > 59 {
+		return fmt.Errorf("%w: invalid time %q", ErrInvalidExpression, p.raw[p.pos-1])
+	}
+
+	p.setClock(hour, minute, second, nanos)
+	return nil
+}
+
+// date consumes a calendar date like "2025-06-28".
+func (p *parser) date() error {
+	d, err := parse.Date(p.raw[p.pos], parse.DateOptions{Location: p.t.Location(), Now: p.now})
+	if err != nil {
+		return p.unexpected()
+	}
+	p.pos++
+
+	y, m, day := d.Date()
+	if p.clockSet {
+		p.t = Wall(y, m, day, p.t.Hour(), p.t.Minute(), p.t.Second(), p.t.Nanosecond(), p.t.Location())
+	} else {
+		p.t = Wall(y, m, day, 0, 0, 0, 0, p.t.Location())
+	}
+	return nil
+}
+
+// moveDate moves the date by a number of days. The time of day is reset to midnight,
+// unless the expression has already named one.
+func (p *parser) moveDate(days int) {
+	y, m, d := p.t.Date()
+	if p.clockSet {
+		p.t = Wall(y, m, d+days, p.t.Hour(), p.t.Minute(), p.t.Second(), p.t.Nanosecond(), p.t.Location())
+		return
+	}
+	p.t = Wall(y, m, d+days, 0, 0, 0, 0, p.t.Location())
+}
+
+// moveWeekday moves to a weekday. "this" (or no direction) is the next occurrence including today,
+// "next" is the next occurrence after today and "last" is the most recent occurrence before today.
+func (p *parser) moveWeekday(day time.Weekday, direction string) {
+	forward := (int(day) - int(p.t.Weekday()) + 7) % 7
+	switch direction {
+	case "next":
+		if forward == 0 {
+			forward = 7
+		}
+		p.moveDate(forward)
+	case "last":
+		backward := (int(p.t.Weekday()) - int(day) + 7) % 7
+		if backward == 0 {
+			backward = 7
+		}
+		p.moveDate(-backward)
+	default:
+		p.moveDate(forward)
+	}
+}
+
+func (p *parser) setClock(hour, minute, second, nanos int) {
+	y, m, d := p.t.Date()
+	p.t = Wall(y, m, d, hour, minute, second, nanos, p.t.Location())
+	p.clockSet = true
+}
+
+func isMeridiem(word string) bool {
+	return word == "am" || word == "pm"
+}
@@ -0,0 +1,279 @@
+package natural
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Saturday, June 28, 2025 in UTC.
+	now := time.Date(2025, time.June, 28, 1, 36, 38, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		now      time.Time
+		loc      *time.Location
+		expected time.Time
+		err      error
+	}{
+		{
+			name:     "now",
+			input:    "now",
+			expected: now,
+		},
+		{
+			name:     "today",
+			input:    "today",
+			expected: time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "today in the reference location",
+			input:    "today",
+			loc:      newYork,
+			expected: time.Date(2025, time.June, 27, 0, 0, 0, 0, newYork),
+		},
+		{
+			name:     "days ago",
+			input:    "3 days ago",
+			expected: now.AddDate(0, 0, -3),
+		},
+		{
+			name:     "a word amount",
+			input:    "an hour ago",
+			expected: now.Add(-time.Hour),
+		},
+		{
+			name:     "in hours",
+			input:    "in 2 hours",
+			expected: now.Add(2 * time.Hour),
+		},
+		{
+			name:     "weeks from now",
+			input:    "two weeks from now",
+			expected: now.AddDate(0, 0, 14),
+		},
+		{
+			name:     "compact offset",
+			input:    "-90min",
+			expected: now.Add(-90 * time.Minute),
+		},
+		{
+			name:     "signed offset",
+			input:    "+1 month",
+			expected: now.AddDate(0, 1, 0),
+		},
+		{
+			name:     "next weekday with a time",
+			input:    "next monday 09:00",
+			expected: time.Date(2025, time.June, 30, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "next weekday on the same weekday",
+			input:    "next saturday",
+			expected: time.Date(2025, time.July, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "bare weekday on the same weekday is today",
+			input:    "saturday",
+			expected: time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "last weekday with a 12-hour time",
+			input:    "last fri at 5:30pm",
+			expected: time.Date(2025, time.June, 27, 17, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "tomorrow noon in a zone",
+			input:    "tomorrow noon in Asia/Tokyo",
+			expected: time.Date(2025, time.June, 29, 12, 0, 0, 0, tokyo),
+		},
+		{
+			name:     "time before the date",
+			input:    "noon tomorrow",
+			expected: time.Date(2025, time.June, 29, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "yesterday midnight",
+			input:    "yesterday midnight",
+			expected: time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "separate meridiem",
+			input:    "today 9 am",
+			expected: time.Date(2025, time.June, 28, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "start of month",
+			input:    "start of month",
+			expected: time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "end of quarter",
+			input:    "end of quarter",
+			expected: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+		{
+			name:     "start of week is monday",
+			input:    "start of the week",
+			expected: time.Date(2025, time.June, 23, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "beginning of next year",
+			input:    "beginning of next year",
+			expected: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "end of last month in a zone",
+			input:    "end of last month UTC",
+			loc:      newYork,
+			expected: time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+		{
+			name:     "next week",
+			input:    "next week",
+			expected: now.AddDate(0, 0, 7),
+		},
+		{
+			name:     "date anchor with time",
+			input:    "2025-01-15 14:00",
+			loc:      newYork,
+			expected: time.Date(2025, time.January, 15, 14, 0, 0, 0, newYork),
+		},
+		{
+			name:     "DST gap moves forward",
+			input:    "tomorrow 02:30",
+			now:      time.Date(2025, time.March, 8, 12, 0, 0, 0, newYork),
+			loc:      newYork,
+			expected: time.Date(2025, time.March, 9, 7, 30, 0, 0, time.UTC), // 03:30 EDT
+		},
+		{
+			name:     "DST overlap picks the earlier instant",
+			input:    "tomorrow 01:30",
+			now:      time.Date(2025, time.November, 1, 12, 0, 0, 0, newYork),
+			loc:      newYork,
+			expected: time.Date(2025, time.November, 2, 5, 30, 0, 0, time.UTC), // 01:30 EDT
+		},
+		{
+			name:     "calendar days keep the wall clock across DST",
+			input:    "1 day ago",
+			now:      time.Date(2025, time.March, 9, 12, 0, 0, 0, newYork),
+			loc:      newYork,
+			expected: time.Date(2025, time.March, 8, 12, 0, 0, 0, newYork),
+		},
+		{
+			name:     "hours are elapsed time across DST",
+			input:    "24 hours ago",
+			now:      time.Date(2025, time.March, 9, 12, 0, 0, 0, newYork),
+			loc:      newYork,
+			expected: time.Date(2025, time.March, 8, 11, 0, 0, 0, newYork),
+		},
+		{
+			name:  "missing direction",
+			input: "3 days",
+			err:   ErrInvalidExpression,
+		},
+		{
+			name:  "unknown unit",
+			input: "3 fortnights ago",
+			err:   ErrInvalidExpression,
+		},
+		{
+			name:  "invalid hour",
+			input: "13pm",
+			err:   ErrInvalidExpression,
+		},
+		{
+			name:  "gibberish",
+			input: "banana",
+			err:   ErrInvalidExpression,
+		},
+		{
+			name:  "empty",
+			input: " ",
+			err:   ErrInvalidExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref := tt.now
+			if ref.IsZero() {
+				ref = now
+			}
+			loc := tt.loc
+			if loc == nil {
+				loc = time.UTC
+			}
+
+			result, err := Parse(tt.input, Options{Now: ref, Location: loc})
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseLoadZone(t *testing.T) {
+	now := time.Date(2025, time.June, 28, 1, 36, 38, 0, time.UTC)
+	office := time.FixedZone("office", 9*60*60)
+	load := func(name string) (*time.Location, error) {
+		if name == "office" {
+			return office, nil
+		}
+		return nil, errors.New("unknown zone")
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+		err      error
+	}{
+		{
+			name:     "loaded zone",
+			input:    "tomorrow noon in office",
+			expected: time.Date(2025, time.June, 29, 12, 0, 0, 0, office),
+		},
+		{
+			name:     "IANA zones still load",
+			input:    "noon in Asia/Tokyo",
+			expected: time.Date(2025, time.June, 28, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "offsets are not zones",
+			input:    "in 2 hours",
+			expected: now.Add(2 * time.Hour),
+		},
+		{
+			name:  "unknown zone",
+			input: "noon in attic",
+			err:   ErrInvalidExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Parse(tt.input, Options{Now: now, Location: time.UTC, LoadZone: load})
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
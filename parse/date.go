@@ -0,0 +1,199 @@
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateOptions control how human-readable dates are parsed by Date.
+type DateOptions struct {
+	// Location is used for inputs that don't include a UTC offset or zone. The default is `Local`.
+	Location *time.Location
+
+	// Now is the reference for inputs that don't include a year, like syslog timestamps.
+	// The default is the current time.
+	Now time.Time
+}
+
+// dateLayouts are the formats accepted by Date, roughly in order of popularity.
+var dateLayouts = []string{
+	// RFC 3339 and ISO 8601
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	"20060102T150405.999999999Z0700",
+	"20060102T150405Z0700",
+	"20060102T150405",
+	"20060102T1504",
+
+	// Go's time.Time.String(), with and without a fractional second
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05.999999999 -0700",
+
+	// Common date-times
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04Z07:00",
+	"2006-01-02 15:04",
+	"2006/01/02 15:04:05.999999999",
+	"2006/01/02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+	"20060102",
+
+	// RFC 1123, RFC 2822 and HTTP dates
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"2 Jan 2006 15:04:05 MST",
+	"Mon, 2 Jan 2006 15:04 -0700",
+	time.RFC850,
+	time.RFC822Z,
+	time.RFC822,
+
+	// Unix date, asctime and friends
+	time.UnixDate,
+	time.RubyDate,
+	time.ANSIC,
+}
+
+// yearlessLayouts are syslog (RFC 3164) formats, which don't include a year.
+var yearlessLayouts = []string{
+	time.StampNano,
+	time.Stamp,
+}
+
+var (
+	// weekDate matches ISO 8601 week dates, e.g. 2025-W26-6 or 2025W266, with an optional time.
+	weekDate = regexp.MustCompile(`^(\d{4})-?W(\d{2})-?([1-7])([T ].*)?$`)
+
+	// ordinalDate matches ISO 8601 ordinal dates, e.g. 2025-179 or 2025179, with an optional time.
+	ordinalDate = regexp.MustCompile(`^(\d{4})-?(\d{3})([T ].*)?$`)
+
+	// monotonic matches the monotonic clock reading that time.Time.String() appends, e.g. "m=+0.000012".
+	monotonic = regexp.MustCompile(` m=[+-]\d+\.\d+$`)
+)
+
+// Date parses a human-readable date-time into a time.Time. It accepts RFC 3339 and ISO 8601 (including week
+// and ordinal dates), RFC 1123 and RFC 2822, HTTP dates, syslog timestamps, the output of time.Time.String()
+// and common forms like "2006-01-02 15:04".
+func Date(s string, opts DateOptions) (time.Time, error) {
+	loc := opts.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	s = strings.TrimSpace(s)
+	s = monotonic.ReplaceAllString(s, "")
+
+	s, err := calendarDate(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, layout := range dateLayouts {
+		t, err := time.ParseInLocation(layout, s, loc)
+		if err == nil {
+			return zoneAbbreviation(t, layout, loc)
+		}
+	}
+
+	for _, layout := range yearlessLayouts {
+		t, err := time.ParseInLocation(layout, s, loc)
+		if err == nil {
+			return withYear(t, now.In(loc)), nil
+		}
+	}
+
+	return time.Time{}, ErrInvalidFormat
+}
+
+// rfc2822Zones are the zone names RFC 2822 allows in place of an offset.
+var rfc2822Zones = map[string]int{
+	"UT":  0,
+	"GMT": 0,
+	"EST": -5 * 3600,
+	"EDT": -4 * 3600,
+	"CST": -6 * 3600,
+	"CDT": -5 * 3600,
+	"MST": -7 * 3600,
+	"MDT": -6 * 3600,
+	"PST": -8 * 3600,
+	"PDT": -7 * 3600,
+}
+
+// zoneAbbreviation fixes the offset of a time parsed with a layout that has a zone abbreviation but no
+// offset. time.ParseInLocation only knows the abbreviations of loc, and gives any other one a zero offset,
+// so the RFC 2822 zone names are mapped to their offsets and other abbreviations are errors.
+func zoneAbbreviation(t time.Time, layout string, loc *time.Location) (time.Time, error) {
+	if !strings.Contains(layout, "MST") || strings.Contains(layout, "-0700") {
+		return t, nil
+	}
+	if t.Location() == loc || t.Location() == time.UTC {
+		return t, nil
+	}
+
+	name, offset := t.Zone()
+	if offset, ok := rfc2822Zones[name]; ok {
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(),
+			time.FixedZone(name, offset)), nil
+	}
+	if offset == 0 {
+		return time.Time{}, fmt.Errorf("%w: unknown zone abbreviation %s", ErrInvalidFormat, name)
+	}
+	return t, nil
+}
+
+// calendarDate rewrites ISO 8601 week and ordinal dates as calendar dates, so they can be read by
+// the standard layouts. Other inputs are returned unchanged.
+func calendarDate(s string) (string, error) {
+	if m := weekDate.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		week, _ := strconv.Atoi(m[2])
+		day, _ := strconv.Atoi(m[3])
+
+		// Week 1 is the week with the year's first Thursday, which always contains January 4th.
+		jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+		date := monday.AddDate(0, 0, (week-1)*7+day-1)
+		if isoYear, isoWeek := date.ISOWeek(); week < 1 || isoYear != year || isoWeek != week {
+			return "", fmt.Errorf("%w: %d has no week %d", ErrInvalidFormat, year, week)
+		}
+		return date.Format(time.DateOnly) + m[4], nil
+	}
+
+	if m := ordinalDate.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		day, _ := strconv.Atoi(m[2])
+
+		date := time.Date(year, time.January, day, 0, 0, 0, 0, time.UTC)
+		if day < 1 || date.Year() != year {
+			return "", fmt.Errorf("%w: %d has no day %d", ErrInvalidFormat, year, day)
+		}
+		return date.Format(time.DateOnly) + m[3], nil
+	}
+
+	return s, nil
+}
+
+// withYear sets the year of a syslog timestamp, assuming it's the most recent occurrence relative to now.
+func withYear(t, now time.Time) time.Time {
+	year := now.Year()
+	candidate := time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+
+	// Allow a day of clock skew before assuming the log line is from last year.
+	if candidate.After(now.Add(24 * time.Hour)) {
+		candidate = time.Date(year-1, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	}
+	return candidate
+}
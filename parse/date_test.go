@@ -0,0 +1,191 @@
+package parse
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDate(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := time.Date(2025, time.June, 28, 1, 36, 38, 0, time.UTC)
+	now := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		loc      *time.Location
+		expected time.Time
+		err      error
+	}{
+		{
+			name:     "RFC 3339",
+			input:    "2025-06-28T01:36:38Z",
+			expected: expected,
+		},
+		{
+			name:     "RFC 3339 with offset and nanoseconds",
+			input:    "2025-06-27T21:36:38.123456789-04:00",
+			expected: expected.Add(123456789),
+		},
+		{
+			name:     "ISO 8601 without zone uses the location",
+			input:    "2025-06-27T21:36:38",
+			loc:      newYork,
+			expected: expected,
+		},
+		{
+			name:     "ISO 8601 basic format",
+			input:    "20250628T013638Z",
+			expected: expected,
+		},
+		{
+			name:     "ISO 8601 week date",
+			input:    "2025-W26-6T01:36:38Z",
+			expected: expected,
+		},
+		{
+			name:     "ISO 8601 week date, basic format",
+			input:    "2025W266T01:36:38Z",
+			expected: expected,
+		},
+		{
+			name:     "ISO 8601 week date in the previous calendar year",
+			input:    "2026-W01-1",
+			loc:      time.UTC,
+			expected: time.Date(2025, time.December, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "ISO 8601 ordinal date",
+			input:    "2025-179T01:36:38Z",
+			expected: expected,
+		},
+		{
+			name:     "ISO 8601 ordinal date, basic format",
+			input:    "2025179",
+			loc:      time.UTC,
+			expected: time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "RFC 1123",
+			input:    "Sat, 28 Jun 2025 01:36:38 GMT",
+			expected: expected,
+		},
+		{
+			name:     "RFC 2822",
+			input:    "Fri, 27 Jun 2025 21:36:38 -0400",
+			expected: expected,
+		},
+		{
+			name:     "RFC 2822 with a single digit day",
+			input:    "Sat, 5 Jul 2025 01:36:38 +0000",
+			expected: time.Date(2025, time.July, 5, 1, 36, 38, 0, time.UTC),
+		},
+		{
+			name:     "RFC 2822 with a US zone name",
+			input:    "Fri, 27 Jun 2025 17:36:38 PST",
+			loc:      time.UTC,
+			expected: expected,
+		},
+		{
+			name:     "RFC 1123 with a US zone name that isn't the location's",
+			input:    "Fri, 27 Jun 2025 21:36:38 EDT",
+			loc:      time.UTC,
+			expected: expected,
+		},
+		{
+			name:     "Unix date with the location's zone abbreviation",
+			input:    "Fri Jun 27 21:36:38 EDT 2025",
+			loc:      newYork,
+			expected: expected,
+		},
+		{
+			name:  "RFC 1123 with an unknown zone abbreviation",
+			input: "Sat, 28 Jun 2025 01:36:38 XYZ",
+			loc:   time.UTC,
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:     "HTTP date, RFC 850",
+			input:    "Saturday, 28-Jun-25 01:36:38 GMT",
+			expected: expected,
+		},
+		{
+			name:     "HTTP date, asctime",
+			input:    "Sat Jun 28 01:36:38 2025",
+			loc:      time.UTC,
+			expected: expected,
+		},
+		{
+			name:     "syslog uses the year of the reference",
+			input:    "Jun 28 01:36:38",
+			loc:      time.UTC,
+			expected: expected,
+		},
+		{
+			name:     "syslog in the future uses the previous year",
+			input:    "Dec 31 23:59:59",
+			loc:      time.UTC,
+			expected: time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name:     "Go time.Time.String()",
+			input:    "2025-06-27 21:36:38.5 -0400 EDT",
+			expected: expected.Add(500 * time.Millisecond),
+		},
+		{
+			name:     "Go time.Time.String() with monotonic clock",
+			input:    "2025-06-28 01:36:38 +0000 UTC m=+0.000012345",
+			expected: expected,
+		},
+		{
+			name:     "date and minutes",
+			input:    "2025-06-28 01:36",
+			loc:      time.UTC,
+			expected: expected.Add(-38 * time.Second),
+		},
+		{
+			name:     "date only",
+			input:    "2025-06-28",
+			loc:      newYork,
+			expected: time.Date(2025, time.June, 28, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "slashes",
+			input:    "2025/06/28 01:36",
+			loc:      time.UTC,
+			expected: expected.Add(-38 * time.Second),
+		},
+		{
+			name:  "invalid week",
+			input: "2025-W53-1",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:  "invalid ordinal day",
+			input: "2025-366",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:  "invalid format",
+			input: "the day after tomorrow",
+			err:   ErrInvalidFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Date(tt.input, DateOptions{Location: tt.loc, Now: now})
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
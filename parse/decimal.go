@@ -0,0 +1,176 @@
+package parse
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxExponent bounds the exponent accepted in scientific notation. Anything larger
+// can't be represented as a time.Time, and this keeps us from building huge digit strings.
+const maxExponent = 64
+
+// decimal is an exact, base-10 representation of a number. Keeping the digits as strings
+// means we never round through a float64 and can keep every digit, even below the nanosecond.
+type decimal struct {
+	negative bool
+	whole    string // digits before the decimal point, without leading zeros
+	fraction string // digits after the decimal point, without trailing zeros
+}
+
+// isDecimal reports whether the input looks like a fractional or scientific-notation number
+// rather than a plain integer.
+func isDecimal(s string) bool {
+	return strings.ContainsAny(s, ".eE")
+}
+
+// parseDecimal reads numbers like "1751074598.123456", "-0.5" and "1.751074598e9" into a decimal.
+func parseDecimal(s string) (decimal, error) {
+	var d decimal
+	if s == "" {
+		return d, ErrInvalidFormat
+	}
+
+	switch s[0] {
+	case '-':
+		d.negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mantissa = s[:i]
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return d, ErrInvalidFormat
+		}
+		if exp > maxExponent || exp < -maxExponent {
+			return d, ErrOverflow
+		}
+		exponent = exp
+	}
+
+	whole, fraction, _ := strings.Cut(mantissa, ".")
+	if whole == "" && fraction == "" {
+		return d, ErrInvalidFormat
+	}
+	if !isDigits(whole) || !isDigits(fraction) {
+		return d, ErrInvalidFormat
+	}
+
+	// Shift the decimal point by the exponent.
+	digits := whole + fraction
+	point := len(whole) + exponent
+	switch {
+	case point < 0:
+		digits = strings.Repeat("0", -point) + digits
+		point = 0
+	case point > len(digits):
+		digits += strings.Repeat("0", point-len(digits))
+	}
+
+	d.whole = strings.TrimLeft(digits[:point], "0")
+	d.fraction = strings.TrimRight(digits[point:], "0")
+	return d, nil
+}
+
+// isInteger reports whether the decimal has no fractional part.
+func (d decimal) isInteger() bool {
+	return d.fraction == ""
+}
+
+// integerString returns the whole portion as a signed integer string that strconv can read.
+func (d decimal) integerString() string {
+	whole := d.whole
+	if whole == "" {
+		whole = "0"
+	}
+	if d.negative {
+		return "-" + whole
+	}
+	return whole
+}
+
+// decimalString parses a fractional, scientific-notation or oversized timestamp. The precision is inferred
+// from the whole portion of the number, and the fractional portion is a fraction of that unit.
+func decimalString(s string) (time.Time, error) {
+	d, err := parseDecimal(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Scientific notation often describes a plain integer, e.g. 1.751074598e9.
+	if d.isInteger() {
+		if ticks, err := strconv.ParseInt(d.integerString(), 10, 64); err == nil {
+			return Int(ticks)
+		}
+	}
+
+	unit, err := detectUnitString(d.integerString())
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, _, err := d.exact(unit.subsecondDigits())
+	if err == nil && !isRenderable(t) {
+		return time.Time{}, ErrOverflow
+	}
+	return t, err
+}
+
+// exact converts the decimal to a time.Time, given the number of whole digits that are a fraction of
+// a second: 0 for seconds, 3 for milliseconds, and so on up to 15 for femtoseconds.
+//
+// The digits finer than a nanosecond are returned separately, without trailing zeros. The time is
+// truncated toward the past, so for negative timestamps the digits count forward from it.
+func (d decimal) exact(subsecond int) (time.Time, string, error) {
+	digits := d.whole + d.fraction
+	if digits == "" {
+		digits = "0"
+	}
+	ticks, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return time.Time{}, "", ErrInvalidFormat
+	}
+	if d.negative {
+		ticks.Neg(ticks)
+	}
+
+	// ticks counts units of 10^-(subsecond+len(fraction)) seconds, so scale is the number of its digits
+	// that are finer than a nanosecond.
+	scale := subsecond + len(d.fraction) - 9
+	nanos, remainder := ticks, new(big.Int)
+	if scale < 0 {
+		nanos.Mul(nanos, pow10Big(-scale))
+	} else {
+		nanos.DivMod(nanos, pow10Big(scale), remainder)
+	}
+
+	seconds, nanoseconds := new(big.Int).DivMod(nanos, nanosPerSecond, new(big.Int))
+	if !seconds.IsInt64() {
+		return time.Time{}, "", ErrOverflow
+	}
+
+	var subnanos string
+	if remainder.Sign() > 0 {
+		rest := remainder.String()
+		subnanos = strings.TrimRight(strings.Repeat("0", scale-len(rest))+rest, "0")
+	}
+	return time.Unix(seconds.Int64(), nanoseconds.Int64()), subnanos, nil
+}
+
+// pow10Big returns 10^n.
+func pow10Big(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -1,4 +1,15 @@
 // Package parse is a module for parsing Unix timestamps into time.Time objects.
 // It attempts to handle ambiguous previsions with a fuzzy parsing approach
 // based on the rules of epochconverter.com.
+//
+// When the precision is known, or the fuzzy rules are too loose, use StringWithOptions
+// to force a Unit or to reject ambiguous values in Strict mode.
+//
+// Timestamps from other epochs, like Windows FILETIME, WebKit or NTP, can be decoded by setting
+// Options.Epoch to one of Epochs. In auto mode they are suggested as alternates.
+//
+// Epochs copied from logs and code, like 1_751_074_598, 1,751,074,598 or 0x685F4726, are cleaned up
+// by Normalize before the precision is inferred.
+//
+// Human-readable date-times, like RFC 3339 or HTTP dates, can be read with Date.
 package parse
@@ -0,0 +1,229 @@
+package parse
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+var ErrUnknownEpoch = errors.New("unknown epoch")
+
+// EpochUnix is the name of the Unix epoch, which is read with the fuzzy precision rules of String.
+const EpochUnix = "unix"
+
+// Epoch is a way of encoding an instant as a number, usually as ticks since an origin.
+type Epoch struct {
+	Name        string
+	Aliases     []string
+	Description string
+
+	decode func(s string) (time.Time, error)
+	encode func(t time.Time) string
+	origin time.Time
+	// tick is the size of a tick of a linear epoch.
+	tick time.Duration
+
+	// excludeCandidates is set for epochs that would match almost every input, so they aren't suggested
+	// as candidates. PTP and GPS are within seconds of Unix, and NTP wraps around into a new era.
+	excludeCandidates bool
+}
+
+// Decode reads a timestamp in this epoch.
+func (e Epoch) Decode(s string) (time.Time, error) {
+	if e.decode == nil {
+		return String(s)
+	}
+	return e.decode(s)
+}
+
+// Encode writes an instant as a timestamp in this epoch. Unix timestamps are written in seconds.
+func (e Epoch) Encode(t time.Time) string {
+	if e.encode == nil {
+		return big.NewInt(t.Unix()).String()
+	}
+	return e.encode(t)
+}
+
+// EncodeTicks writes an instant as a whole number of ticks, rounded down like a clock that counts them, for
+// linear epochs with ticks of up to a second. Day counts, like Julian Days, and the other formats keep their
+// fraction, like Encode.
+func (e Epoch) EncodeTicks(t time.Time) string {
+	if e.tick <= 0 || e.tick > time.Second {
+		return e.Encode(t)
+	}
+	ticks := sinceNanos(e.origin, t)
+	// Div rounds down for a positive divisor, so instants before the origin are rounded down too.
+	return ticks.Div(ticks, big.NewInt(int64(e.tick))).String()
+}
+
+// isUnix reports whether e is the Unix epoch, which is also the zero value.
+func (e Epoch) isUnix() bool {
+	return e.Name == "" || e.Name == EpochUnix
+}
+
+// NewLinearEpoch creates an epoch that counts ticks of a fixed size since the origin. Timestamps can be
+// fractional, and are decoded exactly down to the nanosecond.
+func NewLinearEpoch(name, description string, origin time.Time, tick time.Duration) Epoch {
+	return Epoch{
+		Name:        name,
+		Description: description,
+		origin:      origin,
+		tick:        tick,
+		decode: func(s string) (time.Time, error) {
+			ticks, err := parseRat(s)
+			if err != nil {
+				return time.Time{}, err
+			}
+			nanos := ticks.Mul(ticks, new(big.Rat).SetInt64(int64(tick)))
+			return addNanos(origin, nanos)
+		},
+		encode: func(t time.Time) string {
+			nanos := new(big.Rat).SetInt(sinceNanos(origin, t))
+			return formatRat(nanos.Quo(nanos, new(big.Rat).SetInt64(int64(tick))))
+		},
+	}
+}
+
+var (
+	// epoch1601 is the origin of Windows FILETIME, LDAP and WebKit timestamps.
+	epoch1601 = time.Date(1601, time.January, 1, 0, 0, 0, 0, time.UTC)
+	// epoch0001 is the origin of .NET DateTime ticks.
+	epoch0001 = time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	// epoch2001 is the origin of Cocoa and Core Data absolute times.
+	epoch2001 = time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+	// epoch2000 is the origin of PostgreSQL's internal timestamps.
+	epoch2000 = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	// julianOrigin is noon on November 24, 4714 BC in the proleptic Gregorian calendar, the start of
+	// Julian Day 0.
+	julianOrigin = time.Date(-4713, time.November, 24, 12, 0, 0, 0, time.UTC)
+	// mjdOrigin is the start of Modified Julian Day 0, or Julian Day 2400000.5.
+	mjdOrigin = time.Date(1858, time.November, 17, 0, 0, 0, 0, time.UTC)
+)
+
+const day = 24 * time.Hour
+
+// epochs are the built-in epochs, other than Unix.
+var epochs = []Epoch{
+	withAliases(NewLinearEpoch("filetime", "Windows FILETIME and LDAP/Active Directory: 100ns ticks since 1601-01-01",
+		epoch1601, 100*time.Nanosecond), "windows", "ntfs", "ldap", "ad"),
+	withAliases(NewLinearEpoch("webkit", "WebKit and Chrome: microseconds since 1601-01-01",
+		epoch1601, time.Microsecond), "chrome"),
+	withAliases(NewLinearEpoch("dotnet", ".NET DateTime.Ticks: 100ns ticks since 0001-01-01",
+		epoch0001, 100*time.Nanosecond), "ticks", ".net"),
+	ntpEpoch,
+	ptpEpoch,
+	gpsEpoch,
+	withAliases(NewLinearEpoch("cocoa", "Cocoa and Core Data absolute time: seconds since 2001-01-01",
+		epoch2001, time.Second), "coredata", "apple", "nsdate"),
+	excelEpoch,
+	withAliases(NewLinearEpoch("excel1904", "Excel 1904 date system: days since 1904-01-01",
+		excel1904Origin, day), "mac-excel"),
+	withAliases(NewLinearEpoch("ole", "OLE Automation dates: days since 1899-12-30",
+		oleOrigin, day), "oadate", "variant"),
+	withAliases(NewLinearEpoch("julian", "Julian Day: days since noon on 4714-11-24 BC",
+		julianOrigin, day), "jd"),
+	NewLinearEpoch("mjd", "Modified Julian Day: days since 1858-11-17", mjdOrigin, day),
+	withAliases(NewLinearEpoch("postgres", "PostgreSQL internal timestamps: microseconds since 2000-01-01",
+		epoch2000, time.Microsecond), "postgresql", "pg"),
+}
+
+func withAliases(e Epoch, aliases ...string) Epoch {
+	e.Aliases = aliases
+	return e
+}
+
+// Epochs returns the built-in epochs, starting with Unix.
+func Epochs() []Epoch {
+	unix := Epoch{Name: EpochUnix, Aliases: []string{"posix"},
+		Description: "Unix: seconds since 1970-01-01, or finer precisions inferred from magnitude"}
+	return append([]Epoch{unix}, epochs...)
+}
+
+// LookupEpoch finds a built-in epoch by its name or one of its aliases.
+func LookupEpoch(name string) (Epoch, error) {
+	name = strings.ToLower(name)
+	for _, e := range Epochs() {
+		if e.Name == name {
+			return e, nil
+		}
+		for _, alias := range e.Aliases {
+			if alias == name {
+				return e, nil
+			}
+		}
+	}
+	return Epoch{}, ErrUnknownEpoch
+}
+
+var (
+	plausibleStart = time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	plausibleEnd   = time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// minCandidateAge is how far past its origin a reading must be to be suggested. Small values are more
+// likely to be counters or durations than timestamps in an epoch from around 2000.
+const minCandidateAge = 365 * day
+
+// candidates decodes the input with every built-in epoch other than Unix and the extra epochs, and keeps
+// the readings that land between 1970 and 2100, and at least a year past the origin of the epoch.
+func candidates(s string, extra []Epoch) []Interpretation {
+	var readings []Interpretation
+	for _, e := range append(slices.Clip(epochs), extra...) {
+		if e.excludeCandidates {
+			continue
+		}
+		t, err := e.Decode(s)
+		if err != nil || t.Before(plausibleStart) || !t.Before(plausibleEnd) || t.Sub(e.origin) < minCandidateAge {
+			continue
+		}
+		readings = append(readings, Interpretation{Epoch: e.Name, Time: t})
+	}
+	return readings
+}
+
+// parseRat reads a decimal or scientific-notation number exactly.
+func parseRat(s string) (*big.Rat, error) {
+	// big.Rat also reads fractions and hex, which aren't timestamps.
+	if s == "" || strings.Trim(s, "0123456789+-.eE") != "" {
+		return nil, ErrInvalidFormat
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, ErrInvalidFormat
+	}
+	return r, nil
+}
+
+var nanosPerSecond = big.NewInt(int64(time.Second))
+
+// addNanos adds a number of nanoseconds to the origin. Fractions of a nanosecond are truncated toward
+// the past.
+func addNanos(origin time.Time, nanos *big.Rat) (time.Time, error) {
+	total := new(big.Int).Div(nanos.Num(), nanos.Denom())
+	total.Add(total, big.NewInt(int64(origin.Nanosecond())))
+
+	seconds, remainder := new(big.Int).DivMod(total, nanosPerSecond, new(big.Int))
+	seconds.Add(seconds, big.NewInt(origin.Unix()))
+	if !seconds.IsInt64() {
+		return time.Time{}, ErrOverflow
+	}
+	return time.Unix(seconds.Int64(), remainder.Int64()), nil
+}
+
+// sinceNanos returns the nanoseconds from the origin to t, without the range limits of time.Duration.
+func sinceNanos(origin, t time.Time) *big.Int {
+	seconds := big.NewInt(t.Unix() - origin.Unix())
+	nanos := seconds.Mul(seconds, nanosPerSecond)
+	return nanos.Add(nanos, big.NewInt(int64(t.Nanosecond()-origin.Nanosecond())))
+}
+
+// formatRat writes a rational number as a decimal, with up to nine fractional digits.
+func formatRat(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	s := strings.TrimRight(r.FloatString(9), "0")
+	return strings.TrimSuffix(s, ".")
+}
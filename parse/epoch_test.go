@@ -0,0 +1,346 @@
+package parse
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEpochDecode(t *testing.T) {
+	// Saturday, June 28, 2025 01:36:38 UTC.
+	instant := time.Date(2025, time.June, 28, 1, 36, 38, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		epoch    string
+		input    string
+		expected time.Time
+		err      error
+	}{
+		{
+			name:     "active directory lastLogonTimestamp",
+			epoch:    "ldap",
+			input:    "133955481980000000",
+			expected: instant,
+		},
+		{
+			name:     "filetime keeps 100ns ticks",
+			epoch:    "filetime",
+			input:    "133955481981234567",
+			expected: instant.Add(123_456_700),
+		},
+		{
+			name:     "chrome history",
+			epoch:    "chrome",
+			input:    "13395548198000000",
+			expected: instant,
+		},
+		{
+			name:     ".NET ticks",
+			epoch:    "dotnet",
+			input:    "638866713980000000",
+			expected: instant,
+		},
+		{
+			name:     "fractional ticks",
+			epoch:    "webkit",
+			input:    "13395548198000000.5",
+			expected: instant.Add(500),
+		},
+		{
+			name:     "before the origin",
+			epoch:    "filetime",
+			input:    "-10000000",
+			expected: time.Date(1600, time.December, 31, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name:     "ntp hex",
+			epoch:    "ntp",
+			input:    "EC09C5A6.80000000",
+			expected: instant.Add(500 * time.Millisecond),
+		},
+		{
+			name:     "ntp raw 64-bit value",
+			epoch:    "ntp",
+			input:    "17008342786644115456",
+			expected: instant.Add(500 * time.Millisecond),
+		},
+		{
+			name:     "ntp decimal seconds",
+			epoch:    "ntp",
+			input:    "3960063398.5",
+			expected: instant.Add(500 * time.Millisecond),
+		},
+		{
+			name:     "ntp era rollover",
+			epoch:    "ntp",
+			input:    "00000000.00000000",
+			expected: time.Date(2036, time.February, 7, 6, 28, 16, 0, time.UTC),
+		},
+		{
+			name:  "ntp seconds beyond 32 bits",
+			epoch: "ntp",
+			input: "4294967296.5",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:     "ptp counts TAI",
+			epoch:    "ptp",
+			input:    "1751074635.000000001",
+			expected: instant.Add(1),
+		},
+		{
+			name:     "ptp seconds and nanoseconds",
+			epoch:    "ptp",
+			input:    "1751074635:1",
+			expected: instant.Add(1),
+		},
+		{
+			name:  "ptp nanoseconds beyond a second",
+			epoch: "ptp",
+			input: "1751074635:1000000000",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:  "ptp seconds and nanoseconds beyond 48 bits",
+			epoch: "ptp",
+			input: "281474976710656:0",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:  "ptp seconds beyond 48 bits",
+			epoch: "ptp",
+			input: "281474976710656",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:     "gps week and time of week",
+			epoch:    "gps",
+			input:    "2372:524216",
+			expected: instant,
+		},
+		{
+			name:     "gps seconds",
+			epoch:    "gps",
+			input:    "1435109816",
+			expected: instant,
+		},
+		{
+			name:     "gps epoch",
+			epoch:    "gps",
+			input:    "0:0",
+			expected: time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "gps time of week beyond a week",
+			epoch: "gps",
+			input: "2372:604800",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:     "cocoa absolute time",
+			epoch:    "coredata",
+			input:    "772767398.25",
+			expected: instant.Add(250 * time.Millisecond),
+		},
+		{
+			name:     "excel serial date",
+			epoch:    "excel",
+			input:    "45836.25",
+			expected: time.Date(2025, time.June, 28, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "excel serial before the leap day",
+			epoch:    "excel",
+			input:    "59",
+			expected: time.Date(1900, time.February, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "excel serial after the leap day",
+			epoch:    "excel",
+			input:    "61",
+			expected: time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "excel leap day doesn't exist",
+			epoch: "excel",
+			input: "60.5",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:     "excel 1904 date system",
+			epoch:    "excel1904",
+			input:    "44374",
+			expected: time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "ole automation date",
+			epoch:    "oadate",
+			input:    "1",
+			expected: time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "julian day of the unix epoch",
+			epoch:    "jd",
+			input:    "2440587.5",
+			expected: time.Unix(0, 0),
+		},
+		{
+			name:     "modified julian day",
+			epoch:    "mjd",
+			input:    "60854",
+			expected: time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "postgres",
+			epoch:    "pg",
+			input:    "804389798000001",
+			expected: instant.Add(time.Microsecond),
+		},
+		{
+			name:     "unix",
+			epoch:    "unix",
+			input:    "1751074598",
+			expected: instant,
+		},
+		{
+			name:  "hex is not a timestamp",
+			epoch: "filetime",
+			input: "0x1F",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:  "fractions are not timestamps",
+			epoch: "filetime",
+			input: "1/2",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:  "overflow",
+			epoch: "filetime",
+			input: "1e40",
+			err:   ErrOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			epoch, err := LookupEpoch(tt.epoch)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := epoch.Decode(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if !result.Equal(tt.expected) && err == nil {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestEpochEncode(t *testing.T) {
+	instant := time.Date(2025, time.June, 28, 1, 36, 38, 123_456_789, time.UTC)
+
+	tests := []struct {
+		epoch    string
+		expected string
+	}{
+		{epoch: "unix", expected: "1751074598"},
+		{epoch: "filetime", expected: "133955481981234567.89"},
+		{epoch: "webkit", expected: "13395548198123456.789"},
+		{epoch: "dotnet", expected: "638866713981234567.89"},
+		{epoch: "cocoa", expected: "772767398.123456789"},
+		{epoch: "postgres", expected: "804389798123456.789"},
+		{epoch: "ntp", expected: "ec09c5a6.1f9add38"},
+		{epoch: "ptp", expected: "1751074635.123456789"},
+		{epoch: "gps", expected: "2372:524216.123456789"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.epoch, func(t *testing.T) {
+			epoch, err := LookupEpoch(tt.epoch)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			encoded := epoch.Encode(instant)
+			if encoded != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, encoded)
+			}
+			if tt.epoch == EpochUnix {
+				return
+			}
+			decoded, err := epoch.Decode(encoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !decoded.Equal(instant) {
+				t.Errorf("expected round trip to %v, got %v", instant, decoded)
+			}
+		})
+	}
+}
+
+func TestEpochEncodeTicks(t *testing.T) {
+	instant := time.Date(2025, time.June, 28, 1, 36, 38, 123_456_789, time.UTC)
+	later := NewLinearEpoch("later", "", time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC), time.Millisecond)
+	lookup := func(name string) Epoch {
+		epoch, err := LookupEpoch(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return epoch
+	}
+
+	tests := []struct {
+		name     string
+		epoch    Epoch
+		expected string
+	}{
+		{name: "filetime", epoch: lookup("filetime"), expected: "133955481981234567"},
+		{name: "webkit", epoch: lookup("webkit"), expected: "13395548198123456"},
+		{name: "cocoa", epoch: lookup("cocoa"), expected: "772767398"},
+		{name: "before the origin", epoch: later, expected: "-142381401877"},
+		{name: "day count keeps its fraction", epoch: lookup("mjd"), expected: "60854.06710791"},
+		{name: "other formats keep their fraction", epoch: lookup("ntp"), expected: "ec09c5a6.1f9add38"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if encoded := tt.epoch.EncodeTicks(instant); encoded != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, encoded)
+			}
+		})
+	}
+}
+
+func TestLookupEpoch(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{input: "unix", expected: EpochUnix},
+		{input: "POSIX", expected: EpochUnix},
+		{input: "AD", expected: "filetime"},
+		{input: "chrome", expected: "webkit"},
+		{input: ".NET", expected: "dotnet"},
+		{input: "ieee1588", expected: "ptp"},
+		{input: "mayan", err: ErrUnknownEpoch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			epoch, err := LookupEpoch(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if epoch.Name != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, epoch.Name)
+			}
+		})
+	}
+}
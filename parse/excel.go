@@ -0,0 +1,59 @@
+package parse
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// excelEpoch reads serial dates in the default 1900 date system of Excel. Serial dates have no time
+// zone, so they are read as UTC.
+var excelEpoch = Epoch{
+	Name:        "excel",
+	Aliases:     []string{"excel1900", "lotus"},
+	Description: "Excel 1900 date system: days since 1899-12-31, including the leap day of 1900",
+	decode:      decodeExcel,
+	encode:      encodeExcel,
+	origin:      oleOrigin,
+}
+
+var (
+	// oleOrigin is day 0 of OLE Automation dates. Excel serials from March 1900 count from it too.
+	oleOrigin = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+	// excel1904Origin is day 0 of the 1904 date system, the default of early Mac versions of Excel.
+	excel1904Origin = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// excelLeapDay is the serial of February 29, 1900, which Excel kept for compatibility with Lotus 1-2-3
+	// even though 1900 wasn't a leap year.
+	excelLeapDay = big.NewRat(60, 1)
+	// excelMarch is the serial of March 1, 1900, the first day counted correctly from oleOrigin.
+	excelMarch = big.NewRat(61, 1)
+)
+
+func decodeExcel(s string) (time.Time, error) {
+	days, err := parseRat(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	origin := oleOrigin
+	switch {
+	case days.Sign() < 0:
+		return time.Time{}, fmt.Errorf("%w: Excel serial dates can't be negative", ErrInvalidFormat)
+	case days.Cmp(excelLeapDay) >= 0 && days.Cmp(excelMarch) < 0:
+		return time.Time{}, fmt.Errorf("%w: Excel serial 60 is February 29, 1900, which doesn't exist", ErrInvalidFormat)
+	case days.Cmp(excelLeapDay) < 0:
+		origin = oleOrigin.AddDate(0, 0, 1)
+	}
+
+	return addNanos(origin, days.Mul(days, new(big.Rat).SetInt64(int64(day))))
+}
+
+func encodeExcel(t time.Time) string {
+	origin := oleOrigin
+	if t.Before(time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC)) {
+		origin = oleOrigin.AddDate(0, 0, 1)
+	}
+	nanos := new(big.Rat).SetInt(sinceNanos(origin, t))
+	return formatRat(nanos.Quo(nanos, new(big.Rat).SetInt64(int64(day))))
+}
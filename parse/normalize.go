@@ -0,0 +1,124 @@
+package parse
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Normalize cleans up the ways timestamps are written in logs and code, so they can be parsed:
+//
+//   - digit separators are removed, either underscores between digits like 1_751_074_598, or commas
+//     between groups of three digits like 1,751,074,598.
+//   - hex (0x685F4726), octal (0o15027643446) and binary (0b1101000...) integers are converted to decimal.
+//   - a leading plus sign is dropped.
+//
+// Other input is returned unchanged, apart from surrounding whitespace, and is left for the parser to
+// reject.
+func Normalize(s string) (string, error) {
+	s = strings.TrimSpace(s)
+
+	sign, rest := "", s
+	if rest != "" && (rest[0] == '+' || rest[0] == '-') {
+		if rest[0] == '-' {
+			sign = "-"
+		}
+		rest = rest[1:]
+	}
+	if rest != "" && (rest[0] == '+' || rest[0] == '-') {
+		return "", ErrInvalidFormat
+	}
+
+	if base := radix(rest); base != 0 {
+		digits, err := removeUnderscores(rest[2:], isAlphanumeric)
+		if err != nil {
+			return "", err
+		}
+		// big.Int also reads a sign, which has to come before the prefix.
+		if digits != "" && (digits[0] == '+' || digits[0] == '-') {
+			return "", ErrInvalidFormat
+		}
+		n, ok := new(big.Int).SetString(digits, base)
+		if !ok {
+			return "", ErrInvalidFormat
+		}
+		return sign + n.String(), nil
+	}
+
+	var err error
+	if strings.Contains(rest, "_") {
+		rest, err = removeUnderscores(rest, isDigit)
+		if err != nil {
+			return "", err
+		}
+	}
+	if strings.Contains(rest, ",") {
+		rest, err = removeThousands(rest)
+		if err != nil {
+			return "", err
+		}
+	}
+	return sign + rest, nil
+}
+
+// radix returns the base of an integer with a 0x, 0o or 0b prefix, or 0 for anything else. A plain
+// leading zero is decimal, since epochs are often zero-padded.
+func radix(s string) int {
+	if len(s) < 2 || s[0] != '0' {
+		return 0
+	}
+	switch s[1] {
+	case 'x', 'X':
+		return 16
+	case 'o', 'O':
+		return 8
+	case 'b', 'B':
+		return 2
+	default:
+		return 0
+	}
+}
+
+// removeUnderscores removes underscores that separate digits, like Go number literals. Leading, trailing
+// and repeated underscores are rejected.
+func removeUnderscores(s string, digit func(byte) bool) (string, error) {
+	for i := 0; i < len(s); i++ {
+		if s[i] != '_' {
+			continue
+		}
+		if i == 0 || i == len(s)-1 || !digit(s[i-1]) || !digit(s[i+1]) {
+			return "", ErrInvalidFormat
+		}
+	}
+	return strings.ReplaceAll(s, "_", ""), nil
+}
+
+// removeThousands removes commas that group the whole portion of a number into thousands. Commas
+// anywhere else are rejected, since some locales use them as the decimal point.
+func removeThousands(s string) (string, error) {
+	end := strings.IndexAny(s, ".eE")
+	if end < 0 {
+		end = len(s)
+	}
+	if strings.Contains(s[end:], ",") {
+		return "", ErrInvalidFormat
+	}
+
+	groups := strings.Split(s[:end], ",")
+	if len(groups[0]) == 0 || len(groups[0]) > 3 {
+		return "", ErrInvalidFormat
+	}
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			return "", ErrInvalidFormat
+		}
+	}
+	return strings.Join(groups, "") + s[end:], nil
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+func isAlphanumeric(b byte) bool {
+	return isDigit(b) || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+}
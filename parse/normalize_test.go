@@ -0,0 +1,54 @@
+package parse
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		err      error
+	}{
+		{name: "plain", input: "1751074598", expected: "1751074598"},
+		{name: "whitespace", input: " 1751074598\n", expected: "1751074598"},
+		{name: "plus sign", input: "+1751074598", expected: "1751074598"},
+		{name: "minus sign", input: "-1751074598", expected: "-1751074598"},
+		{name: "underscores", input: "1_751_074_598", expected: "1751074598"},
+		{name: "underscores in a fraction", input: "1751074598.123_456", expected: "1751074598.123456"},
+		{name: "commas", input: "1,751,074,598", expected: "1751074598"},
+		{name: "commas with a fraction", input: "1,751,074,598.5", expected: "1751074598.5"},
+		{name: "hex", input: "0x685F4726", expected: "1751074598"},
+		{name: "hex with underscores", input: "0x685f_4726", expected: "1751074598"},
+		{name: "negative hex", input: "-0X10", expected: "-16"},
+		{name: "octal", input: "0o15027643446", expected: "1751074598"},
+		{name: "binary", input: "0b1010", expected: "10"},
+		{name: "leading zero is decimal", input: "0755", expected: "0755"},
+		{name: "hex beyond int64", input: "0x10000000000000000", expected: "18446744073709551616"},
+		{name: "other input is unchanged", input: "orange", expected: "orange"},
+		{name: "leading underscore", input: "_1751074598", err: ErrInvalidFormat},
+		{name: "double underscore", input: "1__751", err: ErrInvalidFormat},
+		{name: "trailing underscore", input: "1751_", err: ErrInvalidFormat},
+		{name: "comma as a decimal point", input: "1751074598,5", err: ErrInvalidFormat},
+		{name: "misplaced comma", input: "17,51,074,598", err: ErrInvalidFormat},
+		{name: "comma in a fraction", input: "1.000,5", err: ErrInvalidFormat},
+		{name: "invalid hex digit", input: "0x12g4", err: ErrInvalidFormat},
+		{name: "empty hex", input: "0x", err: ErrInvalidFormat},
+		{name: "sign after the prefix", input: "0x-10", err: ErrInvalidFormat},
+		{name: "two signs", input: "+-10", err: ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Normalize(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
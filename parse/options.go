@@ -0,0 +1,213 @@
+package parse
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	ErrInvalidUnit  = errors.New("invalid precision")
+	ErrUnitConflict = errors.New("conflicting precisions")
+	ErrAmbiguous    = errors.New("ambiguous precision")
+)
+
+// Unit is the precision of a Unix timestamp.
+type Unit string
+
+const (
+	// Auto infers the precision from the magnitude of the timestamp.
+	Auto         Unit = ""
+	Seconds      Unit = "seconds"
+	Milliseconds Unit = "milliseconds"
+	Microseconds Unit = "microseconds"
+	Nanoseconds  Unit = "nanoseconds"
+	Picoseconds  Unit = "picoseconds"
+	Femtoseconds Unit = "femtoseconds"
+)
+
+// ParseUnit reads the name of a precision, including the common shorthands:
+// seconds [s, secs], milliseconds [ms, millis], microseconds [us, µs, micros], nanoseconds [ns, nanos],
+// picoseconds [ps, picos] and femtoseconds [fs, femtos].
+// "auto" and the empty string both return Auto.
+func ParseUnit(s string) (Unit, error) {
+	switch Unit(strings.ToLower(s)) {
+	case Auto, "auto":
+		return Auto, nil
+	case Seconds, "s", "sec", "secs", "second":
+		return Seconds, nil
+	case Milliseconds, "ms", "milli", "millis", "millisecond":
+		return Milliseconds, nil
+	case Microseconds, "us", "µs", "μs", "micro", "micros", "microsecond":
+		return Microseconds, nil
+	case Nanoseconds, "ns", "nano", "nanos", "nanosecond":
+		return Nanoseconds, nil
+	case Picoseconds, "ps", "pico", "picos", "picosecond":
+		return Picoseconds, nil
+	case Femtoseconds, "fs", "femto", "femtos", "femtosecond":
+		return Femtoseconds, nil
+	default:
+		return Auto, ErrInvalidUnit
+	}
+}
+
+// Short returns the abbreviation of the unit, e.g. "ms" for milliseconds.
+func (u Unit) Short() string {
+	switch u {
+	case Seconds:
+		return "s"
+	case Milliseconds:
+		return "ms"
+	case Microseconds:
+		return "us"
+	case Nanoseconds:
+		return "ns"
+	case Picoseconds:
+		return "ps"
+	case Femtoseconds:
+		return "fs"
+	default:
+		return "auto"
+	}
+}
+
+// subsecondDigits returns the number of digits in a timestamp of this unit that are a fraction of a second.
+func (u Unit) subsecondDigits() int {
+	switch u {
+	case Milliseconds:
+		return 3
+	case Microseconds:
+		return 6
+	case Nanoseconds:
+		return 9
+	case Picoseconds:
+		return 12
+	case Femtoseconds:
+		return 15
+	default:
+		return 0
+	}
+}
+
+// Options control how timestamps are parsed by StringWithOptions and IntWithOptions.
+type Options struct {
+	// Unit forces the precision of the timestamp instead of inferring it from the magnitude.
+	// It only applies to the Unix epoch.
+	Unit Unit
+
+	// Epoch selects how the timestamp is encoded. The zero value is the Unix epoch.
+	Epoch Epoch
+
+	// Epochs are suggested as candidates along with the built-in epochs, like user-defined epochs
+	// created with NewLinearEpoch.
+	Epochs []Epoch
+
+	// Strict rejects timestamps with an inferred precision that falls in an ambiguous zone,
+	// where the value is either very close to the Unix epoch or very far from the present.
+	// It has no effect when the precision is known.
+	Strict bool
+}
+
+// StringWithOptions parses a string into a time.Time like String, but allows the caller to control
+// the precision. The precision can also be given as part of the input, either as a prefix like
+// "ms:1751074598123" or as a suffix like "1751074598123456us". Hex input only takes a prefix.
+//
+// Return values are set with the default `Local` time zone.
+func StringWithOptions(s string, opts Options) (time.Time, error) {
+	result, err := Analyze(s, opts)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return result.Time, nil
+}
+
+// IntWithOptions parses an int64 into a time.Time like Int, but allows the caller to control the precision.
+//
+// Return values are set with the default `Local` time zone.
+func IntWithOptions(input int64, opts Options) (time.Time, error) {
+	unit := opts.Unit
+	if unit == Auto {
+		unit = detectUnit(input)
+	}
+
+	t := fromInt(input, unit.subsecondDigits())
+	if opts.Unit == Auto && opts.Strict && isAmbiguous(t) {
+		return time.Time{}, ErrAmbiguous
+	}
+	return t, nil
+}
+
+// withUnit parses the input with a known precision. It also returns the digits finer than a nanosecond,
+// which time.Time can't hold.
+func withUnit(s string, unit Unit) (time.Time, string, error) {
+	if !isDecimal(s) && unit.subsecondDigits() <= 9 {
+		ticks, err := strconv.ParseInt(s, 10, 64)
+		if err == nil {
+			return fromInt(ticks, unit.subsecondDigits()), "", nil
+		}
+		if !errors.Is(err, strconv.ErrRange) {
+			return time.Time{}, "", ErrInvalidFormat
+		}
+	}
+
+	d, err := parseDecimal(s)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	return d.exact(unit.subsecondDigits())
+}
+
+// inferUnit infers the precision of the input from the magnitude of its whole portion.
+func inferUnit(s string) (Unit, error) {
+	whole := s
+	if isDecimal(s) {
+		d, err := parseDecimal(s)
+		if err != nil {
+			return Auto, err
+		}
+		whole = d.integerString()
+	}
+	return detectUnitString(whole)
+}
+
+// isAmbiguous reports whether an inferred timestamp is outside the range where the thresholds in Int
+// are reliable: roughly 1973-03-03 to 2286-11-20, and the mirror of that range before 1970.
+// Outside it, the same digits read as a plausible date in another precision, or as a duration.
+func isAmbiguous(t time.Time) bool {
+	seconds := t.Unix()
+	if seconds < 0 {
+		seconds = -seconds
+	}
+	return seconds < 100_000_000 || seconds >= 10_000_000_000
+}
+
+// splitUnit removes a precision prefix ("ms:") or suffix ("ms") from the input.
+// Input without a recognized unit is returned unchanged.
+func splitUnit(s string) (string, Unit, error) {
+	if prefix, rest, found := strings.Cut(s, ":"); found {
+		unit, err := ParseUnit(strings.TrimSpace(prefix))
+		if err != nil || unit == Auto {
+			return s, Auto, ErrInvalidUnit
+		}
+		return strings.TrimSpace(rest), unit, nil
+	}
+
+	// The digits of hex numbers look like units, e.g. 0x1fs.
+	if radix(strings.TrimLeft(s, "+-")) != 0 {
+		return s, Auto, nil
+	}
+
+	i := strings.LastIndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) })
+	suffix := s[i+1:]
+	if suffix == "" {
+		return s, Auto, nil
+	}
+	unit, err := ParseUnit(suffix)
+	if err != nil || unit == Auto {
+		// Let the number parsing report the invalid format.
+		return s, Auto, nil
+	}
+	return strings.TrimSpace(s[:i+1]), unit, nil
+}
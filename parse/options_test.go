@@ -0,0 +1,203 @@
+package parse
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStringWithOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		opts     Options
+		expected time.Time
+		err      error
+	}{
+		{
+			name:     "auto: same as String",
+			input:    "1751074598123",
+			expected: time.Unix(1751074598, 123_000_000),
+		},
+		{
+			name:     "forced milliseconds: small value",
+			input:    "5000",
+			opts:     Options{Unit: Milliseconds},
+			expected: time.Unix(5, 0),
+		},
+		{
+			name:     "forced seconds: value in millisecond range",
+			input:    "100000000000",
+			opts:     Options{Unit: Seconds},
+			expected: time.Unix(100_000_000_000, 0),
+		},
+		{
+			name:     "forced microseconds: negative",
+			input:    "-1500000",
+			opts:     Options{Unit: Microseconds},
+			expected: time.Unix(-1, -500_000_000),
+		},
+		{
+			name:     "forced nanoseconds: small value",
+			input:    "1500",
+			opts:     Options{Unit: Nanoseconds},
+			expected: time.Unix(0, 1500),
+		},
+		{
+			name:     "forced milliseconds: decimal",
+			input:    "1751074598123.4567",
+			opts:     Options{Unit: Milliseconds},
+			expected: time.Unix(1751074598, 123_456_700),
+		},
+		{
+			name:  "forced milliseconds: past the year 9999",
+			input: "99999999999999999999",
+			opts:  Options{Unit: Milliseconds},
+			err:   ErrOverflow,
+		},
+		{
+			name:     "forced picoseconds: int64",
+			input:    "1500000",
+			opts:     Options{Unit: Picoseconds},
+			expected: time.Unix(0, 1500),
+		},
+		{
+			name:     "prefix: ms",
+			input:    "ms:5000",
+			expected: time.Unix(5, 0),
+		},
+		{
+			name:     "prefix: long name with spaces",
+			input:    "micros: 5000000",
+			expected: time.Unix(5, 0),
+		},
+		{
+			name:     "suffix: us",
+			input:    "1751074598123456us",
+			expected: time.Unix(1751074598, 123_456_000),
+		},
+		{
+			name:     "suffix: µs",
+			input:    "1751074598123456µs",
+			expected: time.Unix(1751074598, 123_456_000),
+		},
+		{
+			name:     "suffix: s on a small value",
+			input:    "5000 s",
+			expected: time.Unix(5000, 0),
+		},
+		{
+			name:     "suffix: agrees with option",
+			input:    "5000ms",
+			opts:     Options{Unit: Milliseconds},
+			expected: time.Unix(5, 0),
+		},
+		{
+			name:  "suffix: conflicts with option",
+			input: "5000ms",
+			opts:  Options{Unit: Seconds},
+			err:   ErrUnitConflict,
+		},
+		{
+			name:  "prefix: unknown unit",
+			input: "days:5000",
+			err:   ErrInvalidUnit,
+		},
+		{
+			name:  "suffix: unknown unit",
+			input: "5000days",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:     "strict: unambiguous seconds",
+			input:    "1751074598",
+			opts:     Options{Strict: true},
+			expected: time.Unix(1751074598, 0),
+		},
+		{
+			name:     "strict: unambiguous milliseconds",
+			input:    "100000000000",
+			opts:     Options{Strict: true},
+			expected: time.Unix(100_000_000, 0),
+		},
+		{
+			name:  "strict: small value near the epoch",
+			input: "5000",
+			opts:  Options{Strict: true},
+			err:   ErrAmbiguous,
+		},
+		{
+			name:  "strict: seconds far in the future",
+			input: "99999999999",
+			opts:  Options{Strict: true},
+			err:   ErrAmbiguous,
+		},
+		{
+			name:  "strict: small nanoseconds",
+			input: "9999999999999999",
+			opts:  Options{Strict: true},
+			err:   ErrAmbiguous,
+		},
+		{
+			name:     "strict: ignored with a known unit",
+			input:    "5000",
+			opts:     Options{Unit: Milliseconds, Strict: true},
+			expected: time.Unix(5, 0),
+		},
+		{
+			name:  "invalid format",
+			input: "orange",
+			opts:  Options{Unit: Seconds},
+			err:   ErrInvalidFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StringWithOptions(tt.input, tt.opts)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Unit
+		err      error
+	}{
+		{input: "", expected: Auto},
+		{input: "auto", expected: Auto},
+		{input: "s", expected: Seconds},
+		{input: "secs", expected: Seconds},
+		{input: "seconds", expected: Seconds},
+		{input: "ms", expected: Milliseconds},
+		{input: "millis", expected: Milliseconds},
+		{input: "MS", expected: Milliseconds},
+		{input: "us", expected: Microseconds},
+		{input: "µs", expected: Microseconds},
+		{input: "micros", expected: Microseconds},
+		{input: "ns", expected: Nanoseconds},
+		{input: "nanos", expected: Nanoseconds},
+		{input: "ps", expected: Picoseconds},
+		{input: "femtos", expected: Femtoseconds},
+		{input: "fortnights", err: ErrInvalidUnit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseUnit(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package parse
 
 import (
 	"errors"
+	"math"
+	"math/big"
+	"slices"
 	"strconv"
-	"strings"
 	"time"
 )
 
@@ -16,11 +18,26 @@ var (
 // seconds, but finer precisions are assumed for larger values. For a full description of the behavior,
 // review the package tests.
 //
+// Fractional (1751074598.123456) and scientific-notation (1.751074598e9) inputs are parsed exactly.
+// The precision is inferred from the whole portion and the fraction is a fraction of that unit.
+// Digit separators, radix prefixes and a plus sign are accepted, see Normalize. The precision is
+// inferred from the normalized value.
+//
 // Return values are set with the default `Local` time zone.
 func String(s string) (time.Time, error) {
+	s, err := Normalize(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if isDecimal(s) {
+		return decimalString(s)
+	}
+
 	ticks, err := strconv.Atoi(s)
 	if errors.Is(err, strconv.ErrRange) {
-		return overflowString(s)
+		// Values too large for an int64 are read exactly with math/big.
+		return decimalString(s)
 	}
 	if err != nil {
 		return time.Time{}, ErrInvalidFormat
@@ -37,64 +54,118 @@ func String(s string) (time.Time, error) {
 //
 // Return values are set with the default `Local` time zone.
 func Int(input int64) (time.Time, error) {
-	var seconds, nanoseconds int64
-	switch {
-	// negative nanosecond
-	case input <= -9_999_999_999_999_999:
-		seconds = input / 1_000_000_000
-		nanoseconds = input % 1_000_000_000
+	return fromInt(input, subsecondDigits(input)), nil
+}
+
+// fromInt converts the input to a time.Time, given the number of trailing digits that are a fraction of a second.
+// Digits finer than a nanosecond are truncated toward the past.
+func fromInt(input int64, subsecond int) time.Time {
+	if subsecond > 9 {
+		// A whole number of picoseconds or femtoseconds in an int64 is always within a few hours of 1970.
+		t, _, _ := decimal{negative: input < 0, whole: strconv.FormatUint(absInt(input), 10)}.exact(subsecond)
+		return t
+	}
+
+	perSecond := pow10(subsecond)
+	seconds := input / perSecond
+	nanoseconds := (input % perSecond) * pow10(9-subsecond) // convert the remainder to nanoseconds
+
+	return time.Unix(seconds, nanoseconds)
+}
+
+// Threshold is a range of values, inclusive, and the precision that Int infers for them.
+type Threshold struct {
+	Min  int64
+	Max  int64
+	Unit Unit
+}
+
+// thresholds are the ranges used to infer precision from magnitude, in ascending order.
+// They follow the rules of epochconverter.com.
+var thresholds = []Threshold{
+	// negative nanoseconds
+	{Min: math.MinInt64, Max: -9_999_999_999_999_999, Unit: Nanoseconds},
 	// negative microseconds
-	case input <= -100_000_000_000_000:
-		seconds = input / 1_000_000
-		nanoseconds = (input % 1_000_000) * 1_000 // convert microseconds to nanoseconds
+	{Min: -9_999_999_999_999_998, Max: -100_000_000_000_000, Unit: Microseconds},
 	// negative milliseconds
-	case input <= -30_000_000_000:
-		seconds = input / 1_000
-		nanoseconds = (input % 1_000) * 1_000_000 // convert milliseconds to nanoseconds
+	{Min: -99_999_999_999_999, Max: -30_000_000_000, Unit: Milliseconds},
 	// seconds
-	case input <= 99_999_999_999:
-		seconds = input
+	{Min: -29_999_999_999, Max: 99_999_999_999, Unit: Seconds},
 	// milliseconds
-	case input <= 99_999_999_999_999:
-		seconds = input / 1_000
-		nanoseconds = (input % 1_000) * 1_000_000 // convert milliseconds to nanoseconds
+	{Min: 100_000_000_000, Max: 99_999_999_999_999, Unit: Milliseconds},
 	// microseconds
-	case input <= 9_999_999_999_999_998:
-		seconds = input / 1_000_000
-		nanoseconds = (input % 1_000_000) * 1_000 // convert microseconds to nanoseconds
+	{Min: 100_000_000_000_000, Max: 9_999_999_999_999_998, Unit: Microseconds},
 	// nanoseconds
-	default:
-		seconds = input / 1_000_000_000
-		nanoseconds = input % 1_000_000_000
-	}
+	{Min: 9_999_999_999_999_999, Max: math.MaxInt64, Unit: Nanoseconds},
+}
 
-	return time.Unix(seconds, nanoseconds), nil
+// Thresholds returns the ranges used by Int to infer precision, in ascending order.
+// Values too large for an int64 are nanoseconds up to 20 digits, which reach the year 5138. Beyond that,
+// they are picoseconds up to 23 digits and femtoseconds after, which both start in 1973.
+func Thresholds() []Threshold {
+	return slices.Clone(thresholds)
 }
 
-// overflowString attempts to split a string that's larger than an int64 into nanosecond and
-// second portions.
-func overflowString(raw string) (time.Time, error) {
-	sign := int64(1)
-	input := raw
-	if raw[0] == '-' {
-		sign = -1
-		input = raw[1:]
+// detectUnit infers the precision of the input from its magnitude.
+func detectUnit(input int64) Unit {
+	for _, t := range thresholds {
+		if input <= t.Max {
+			return t.Unit
+		}
 	}
+	return Nanoseconds
+}
 
-	input = strings.TrimLeft(input, "0")
+var (
+	// minPicoseconds and minFemtoseconds extend the thresholds past the range of an int64.
+	minPicoseconds  = pow10Big(20)
+	minFemtoseconds = pow10Big(23)
+)
 
-	nanoseconds, err := strconv.Atoi(input[len(input)-9:])
-	if err != nil {
-		return time.Time{}, ErrInvalidFormat
+// detectUnitString infers the precision of an integer string, which can be too large for an int64.
+func detectUnitString(s string) (Unit, error) {
+	ticks, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return detectUnit(ticks), nil
+	}
+	if !errors.Is(err, strconv.ErrRange) {
+		return Auto, ErrInvalidFormat
 	}
 
-	seconds, err := strconv.Atoi(input[:len(input)-9])
-	if errors.Is(err, strconv.ErrRange) {
-		return time.Time{}, ErrOverflow
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Auto, ErrInvalidFormat
 	}
-	if err != nil {
-		return time.Time{}, ErrInvalidFormat
+	switch n.Abs(n); {
+	case n.Cmp(minFemtoseconds) >= 0:
+		return Femtoseconds, nil
+	case n.Cmp(minPicoseconds) >= 0:
+		return Picoseconds, nil
+	default:
+		return Nanoseconds, nil
+	}
+}
+
+// subsecondDigits infers the precision of the input from its magnitude. It returns the number of
+// trailing digits that are a fraction of a second: 0 for seconds, 3 for milliseconds,
+// 6 for microseconds and 9 for nanoseconds.
+func subsecondDigits(input int64) int {
+	return detectUnit(input).subsecondDigits()
+}
+
+// pow10 returns 10^n for small, non-negative n.
+func pow10(n int) int64 {
+	result := int64(1)
+	for range n {
+		result *= 10
 	}
+	return result
+}
 
-	return time.Unix(sign*int64(seconds), sign*int64(nanoseconds)), nil
+// absInt returns the magnitude of n, which doesn't overflow for math.MinInt64.
+func absInt(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
 }
@@ -111,6 +111,32 @@ func Test(t *testing.T) {
 			input:    "-09999999999999999999", // This is larger than int64 can handle
 			expected: time.Unix(-9999999999, -999999999),
 		},
+		{
+			name:     "valid seconds with digit separators: 1_751_074_598",
+			input:    "1_751_074_598",
+			expected: time.Unix(1751074598, 0),
+		},
+		{
+			name:     "valid milliseconds with thousands separators: 1,751,074,598,123",
+			input:    "1,751,074,598,123",
+			expected: time.Unix(1751074598, 123_000_000),
+		},
+		{
+			name:     "valid hex seconds: 0x685F4726",
+			input:    "0x685F4726",
+			expected: time.Unix(1751074598, 0),
+		},
+		{
+			name:     "valid signed seconds: +1751074598",
+			input:    "+1751074598",
+			expected: time.Unix(1751074598, 0),
+		},
+		{
+			name:     "invalid format: comma as a decimal point",
+			input:    "1751074598,123",
+			expected: time.Time{},
+			err:      ErrInvalidFormat,
+		},
 		{
 			name:     "invalid format: not a number",
 			input:    "invalid",
@@ -124,17 +150,136 @@ func Test(t *testing.T) {
 			err:      ErrInvalidFormat,
 		},
 		{
-			name:     "invalid format: decimal",
+			name:     "valid decimal seconds: 1.0",
 			input:    "1.0",
+			expected: time.Unix(1, 0),
+		},
+		{
+			name:     "valid decimal seconds: 1751074598.123456",
+			input:    "1751074598.123456",
+			expected: time.Unix(1751074598, 123456000),
+		},
+		{
+			name:     "valid decimal seconds: all nanosecond digits kept",
+			input:    "1751074598.123456789",
+			expected: time.Unix(1751074598, 123456789),
+		},
+		{
+			name:     "valid decimal seconds: sub-nanosecond digits truncated",
+			input:    "1751074598.1234567891",
+			expected: time.Unix(1751074598, 123456789),
+		},
+		{
+			name:     "valid decimal seconds: no whole portion",
+			input:    ".5",
+			expected: time.Unix(0, 500_000_000),
+		},
+		{
+			name:     "valid negative decimal seconds: -1.5",
+			input:    "-1.5",
+			expected: time.Unix(-1, -500_000_000),
+		},
+		{
+			name:     "valid decimal milliseconds: 1751074598123.456",
+			input:    "1751074598123.456",
+			expected: time.Unix(1751074598, 123456000),
+		},
+		{
+			name:     "valid decimal nanoseconds: int64 overflow drops the fraction",
+			input:    "9999999999999999999.5",
+			expected: time.Unix(9999999999, 999999999),
+		},
+		{
+			name:     "valid scientific seconds: 1.751074598e9",
+			input:    "1.751074598e9",
+			expected: time.Unix(1751074598, 0),
+		},
+		{
+			name:     "valid scientific seconds with fraction: 1.7510745981234E9",
+			input:    "1.7510745981234E9",
+			expected: time.Unix(1751074598, 123400000),
+		},
+		{
+			name:     "valid scientific seconds with signed exponent: 17510745981e-1",
+			input:    "17510745981e-1",
+			expected: time.Unix(1751074598, 100_000_000),
+		},
+		{
+			name:     "valid scientific milliseconds: 1.751074598123e12",
+			input:    "1.751074598123e12",
+			expected: time.Unix(1751074598, 123_000_000),
+		},
+		{
+			name:     "invalid format: decimal with two points",
+			input:    "1.0.0",
 			expected: time.Time{},
 			err:      ErrInvalidFormat,
 		},
+		{
+			name:     "invalid format: lone decimal point",
+			input:    ".",
+			expected: time.Time{},
+			err:      ErrInvalidFormat,
+		},
+		{
+			name:     "invalid format: missing exponent",
+			input:    "1.5e",
+			expected: time.Time{},
+			err:      ErrInvalidFormat,
+		},
+		{
+			name:     "invalid format: exponent overflow",
+			input:    "1e1000",
+			expected: time.Time{},
+			err:      ErrOverflow,
+		},
+		{
+			name:     "valid picoseconds: 1751074598123456789012",
+			input:    "1751074598123456789012",
+			expected: time.Unix(1751074598, 123456789),
+		},
+		{
+			name:     "valid femtoseconds: 1751074598123456789012345",
+			input:    "1751074598123456789012345",
+			expected: time.Unix(1751074598, 123456789),
+		},
+		{
+			name:     "valid femtoseconds: 99999999999999999999999999",
+			input:    "99999999999999999999999999",
+			expected: time.Unix(99999999999, 999999999),
+		},
+		{
+			name:  "invalid femtoseconds: past the year 9999",
+			input: "99999999999999999999999999999",
+			err:   ErrOverflow,
+		},
+		{
+			name:  "invalid negative nanoseconds: before the year 0",
+			input: "-99999999999999999999",
+			err:   ErrOverflow,
+		},
+		{
+			name:     "valid negative picoseconds: sub-nanosecond digits truncated toward the past",
+			input:    "-100000000000000000001",
+			expected: time.Unix(-100000000, -1),
+		},
+		{
+			name:     "valid scientific femtoseconds: 1.751074598e24",
+			input:    "1.751074598e24",
+			expected: time.Unix(1751074598, 0),
+		},
 		{
 			name:     "invalid format: overflow",
 			input:    "9999999999999999999999999999", // This is larger than int64 can handle for seconds
 			expected: time.Time{},
 			err:      ErrOverflow,
 		},
+		{
+			name:     "invalid format: overflow beyond every precision",
+			input:    "10000000000000000000000000000000000000000",
+			expected: time.Time{},
+			err:      ErrOverflow,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,149 @@
+package parse
+
+import (
+	"time"
+)
+
+// Confidence describes how sure the parser is about the precision of a timestamp.
+type Confidence string
+
+const (
+	// ConfidenceExact means the precision was given by the caller or in the input.
+	ConfidenceExact Confidence = "exact"
+	// ConfidenceHigh means the precision was inferred, and the result is a plausible date.
+	ConfidenceHigh Confidence = "high"
+	// ConfidenceLow means the precision was inferred, but the value falls in an ambiguous zone.
+	// See Options.Strict.
+	ConfidenceLow Confidence = "low"
+)
+
+// Interpretation is a reading of a timestamp in a given epoch and, for Unix timestamps, at a given precision.
+type Interpretation struct {
+	Epoch string
+	Unit  Unit
+	Time  time.Time
+}
+
+// Result is the detailed outcome of parsing a timestamp.
+type Result struct {
+	// Time is the parsed timestamp, set with the default `Local` time zone.
+	Time time.Time
+	// Value is the timestamp that was read. For the Unix epoch it is the decimal number, without a
+	// precision affix or the formatting removed by Normalize.
+	Value string
+	// Epoch is the name of the epoch used. Unit is only set for the Unix epoch.
+	Epoch      string
+	Unit       Unit
+	Confidence Confidence
+
+	// Subnanoseconds are the digits of the timestamp finer than a nanosecond, which Time can't hold, e.g.
+	// "012" for 1751074598123456789012ps. It is empty if there are none. Time is truncated toward the
+	// past, so the digits always count forward from it.
+	Subnanoseconds string
+
+	// Alternates are the readings of the same digits at the other precisions that are plausible,
+	// ordered from coarsest to finest, followed by the other epochs that give a date between 1970 and
+	// 2100 when the precision was inferred. A precision is plausible in the same range Options.Strict
+	// accepts, roughly 1973 to 2286 and its mirror before 1970.
+	Alternates []Interpretation
+}
+
+// units are the precisions read as alternates, from coarsest to finest. Picoseconds and femtoseconds
+// are left out, since digits of a coarser precision only read as instants in the first hours of 1970.
+var units = []Unit{Seconds, Milliseconds, Microseconds, Nanoseconds}
+
+// Analyze parses a string like StringWithOptions, but also reports the precision it used,
+// how confident it is in that precision, and the other plausible readings of the input. Instants
+// outside the years 0 to 9999, which RFC 3339 can't write, are ErrOverflow.
+func Analyze(s string, opts Options) (Result, error) {
+	// Other epochs have their own formats, like GPS week:seconds, so they don't take a precision affix.
+	if !opts.Epoch.isUnix() {
+		if opts.Unit != Auto {
+			return Result{}, ErrUnitConflict
+		}
+		t, err := opts.Epoch.Decode(s)
+		if err != nil {
+			return Result{}, err
+		}
+		if !isRenderable(t) {
+			return Result{}, ErrOverflow
+		}
+		return Result{Time: t, Value: s, Epoch: opts.Epoch.Name, Confidence: ConfidenceExact}, nil
+	}
+
+	s, unit, err := splitUnit(s)
+	if err != nil {
+		return Result{}, err
+	}
+	switch {
+	case unit == Auto:
+		unit = opts.Unit
+	case opts.Unit != Auto && opts.Unit != unit:
+		return Result{}, ErrUnitConflict
+	}
+	s, err = Normalize(s)
+	if err != nil {
+		return Result{}, err
+	}
+
+	confidence := ConfidenceExact
+	if unit == Auto {
+		unit, err = inferUnit(s)
+		if err != nil {
+			return Result{}, err
+		}
+		confidence = ConfidenceHigh
+	}
+
+	t, subnanos, err := withUnit(s, unit)
+	if err != nil {
+		return Result{}, err
+	}
+	if !isRenderable(t) {
+		return Result{}, ErrOverflow
+	}
+
+	if confidence != ConfidenceExact && isAmbiguous(t) {
+		if opts.Strict {
+			return Result{}, ErrAmbiguous
+		}
+		confidence = ConfidenceLow
+	}
+
+	readings := alternates(s, unit)
+	if confidence != ConfidenceExact {
+		readings = append(readings, candidates(s, opts.Epochs)...)
+	}
+
+	return Result{
+		Time:           t,
+		Value:          s,
+		Epoch:          EpochUnix,
+		Unit:           unit,
+		Confidence:     confidence,
+		Subnanoseconds: subnanos,
+		Alternates:     readings,
+	}, nil
+}
+
+// alternates reads the input at every precision other than the one used, keeping the plausible readings.
+func alternates(s string, used Unit) []Interpretation {
+	var readings []Interpretation
+	for _, unit := range units {
+		if unit == used {
+			continue
+		}
+		t, _, err := withUnit(s, unit)
+		if err != nil || isAmbiguous(t) {
+			continue
+		}
+		readings = append(readings, Interpretation{Epoch: EpochUnix, Unit: unit, Time: t})
+	}
+	return readings
+}
+
+// isRenderable reports whether the time can be formatted as RFC 3339, which only allows four-digit years.
+func isRenderable(t time.Time) bool {
+	year := t.UTC().Year()
+	return year >= 0 && year <= 9999
+}
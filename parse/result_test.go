@@ -0,0 +1,216 @@
+package parse
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		opts       Options
+		unit       Unit
+		confidence Confidence
+		value      string
+		subnanos   string
+		alternates []Interpretation
+		err        error
+	}{
+		{
+			name:       "inferred seconds",
+			input:      "1751074598",
+			unit:       Seconds,
+			confidence: ConfidenceHigh,
+			alternates: []Interpretation{
+				{Epoch: "cocoa", Time: time.Date(2056, time.June, 28, 1, 36, 38, 0, time.UTC)},
+			},
+		},
+		{
+			name:       "inferred milliseconds drops implausible precisions",
+			input:      "1751074598123",
+			unit:       Milliseconds,
+			confidence: ConfidenceHigh,
+			alternates: []Interpretation{},
+		},
+		{
+			name:       "inferred nanoseconds beyond int64",
+			input:      "9999999999999999999",
+			unit:       Nanoseconds,
+			confidence: ConfidenceHigh,
+			alternates: []Interpretation{},
+		},
+		{
+			name:       "hex with a precision prefix",
+			input:      "ms:0x197B42DECEB",
+			unit:       Milliseconds,
+			confidence: ConfidenceExact,
+			value:      "1751074598123",
+			alternates: []Interpretation{},
+		},
+		{
+			name:       "digit separators with a precision suffix",
+			input:      "1_751_074_598_123 ms",
+			unit:       Milliseconds,
+			confidence: ConfidenceExact,
+			value:      "1751074598123",
+			alternates: []Interpretation{},
+		},
+		{
+			name:       "inferred picoseconds keep sub-nanosecond digits",
+			input:      "1751074598123456789012",
+			unit:       Picoseconds,
+			confidence: ConfidenceHigh,
+			subnanos:   "012",
+			alternates: []Interpretation{},
+		},
+		{
+			name:       "inferred femtoseconds with a fraction",
+			input:      "1751074598123456789012345.6",
+			unit:       Femtoseconds,
+			confidence: ConfidenceHigh,
+			subnanos:   "0123456",
+			alternates: []Interpretation{},
+		},
+		{
+			name:       "negative sub-nanosecond digits count forward",
+			input:      "-1.0000000005s",
+			unit:       Seconds,
+			confidence: ConfidenceExact,
+			subnanos:   "5",
+			alternates: []Interpretation{},
+		},
+		{
+			name:       "inferred seconds near the epoch",
+			input:      "5000",
+			unit:       Seconds,
+			confidence: ConfidenceLow,
+			alternates: []Interpretation{},
+		},
+		{
+			name:       "forced precision",
+			input:      "1751074598",
+			opts:       Options{Unit: Milliseconds},
+			unit:       Milliseconds,
+			confidence: ConfidenceExact,
+			alternates: []Interpretation{
+				{Unit: Seconds, Time: time.Unix(1751074598, 0)},
+			},
+		},
+		{
+			name:       "precision suffix",
+			input:      "1.5s",
+			unit:       Seconds,
+			confidence: ConfidenceExact,
+			alternates: []Interpretation{},
+		},
+		{
+			name:       "filetime candidate",
+			input:      "133955481980000000",
+			unit:       Nanoseconds,
+			confidence: ConfidenceHigh,
+			alternates: []Interpretation{
+				{Epoch: "filetime", Time: time.Unix(1751074598, 0)},
+			},
+		},
+		{
+			name:       "excel serial date candidates",
+			input:      "45836.5",
+			unit:       Seconds,
+			confidence: ConfidenceLow,
+			alternates: []Interpretation{
+				{Epoch: "excel", Time: time.Date(2025, time.June, 28, 12, 0, 0, 0, time.UTC)},
+				{Epoch: "excel1904", Time: time.Date(2029, time.June, 29, 12, 0, 0, 0, time.UTC)},
+				{Epoch: "ole", Time: time.Date(2025, time.June, 28, 12, 0, 0, 0, time.UTC)},
+				{Epoch: "mjd", Time: time.Date(1984, time.May, 16, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:       "chosen epoch",
+			input:      "13395548198000000",
+			opts:       Options{Epoch: mustLookupEpoch(t, "chrome")},
+			confidence: ConfidenceExact,
+			alternates: []Interpretation{},
+		},
+		{
+			name:       "gps week and time of week",
+			input:      "2372:524216",
+			opts:       Options{Epoch: mustLookupEpoch(t, "gps")},
+			confidence: ConfidenceExact,
+			alternates: []Interpretation{},
+		},
+		{
+			name:  "precision with another epoch",
+			input: "13395548198000000",
+			opts:  Options{Epoch: mustLookupEpoch(t, "chrome"), Unit: Seconds},
+			err:   ErrUnitConflict,
+		},
+		{
+			name:  "strict",
+			input: "5000",
+			opts:  Options{Strict: true},
+			err:   ErrAmbiguous,
+		},
+		{
+			name:  "invalid format",
+			input: "orange",
+			err:   ErrInvalidFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Analyze(tt.input, tt.opts)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if result.Unit != tt.unit {
+				t.Errorf("expected unit %v, got %v", tt.unit, result.Unit)
+			}
+			if result.Confidence != tt.confidence {
+				t.Errorf("expected confidence %v, got %v", tt.confidence, result.Confidence)
+			}
+			if tt.value != "" && result.Value != tt.value {
+				t.Errorf("expected value %v, got %v", tt.value, result.Value)
+			}
+			if result.Subnanoseconds != tt.subnanos {
+				t.Errorf("expected sub-nanosecond digits %q, got %q", tt.subnanos, result.Subnanoseconds)
+			}
+			if len(result.Alternates) != len(tt.alternates) {
+				t.Fatalf("expected %d alternates, got %v", len(tt.alternates), result.Alternates)
+			}
+			for i, expected := range tt.alternates {
+				actual := result.Alternates[i]
+				if expected.Epoch != "" && actual.Epoch != expected.Epoch {
+					t.Errorf("expected alternate %v, got %v", expected, actual)
+				}
+				if actual.Unit != expected.Unit || !actual.Time.Equal(expected.Time) {
+					t.Errorf("expected alternate %v, got %v", expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestThresholds(t *testing.T) {
+	// The thresholds must be contiguous so every int64 has exactly one precision.
+	ranges := Thresholds()
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i].Min != ranges[i-1].Max+1 {
+			t.Errorf("gap between %v and %v", ranges[i-1], ranges[i])
+		}
+	}
+}
+
+func mustLookupEpoch(t *testing.T, name string) Epoch {
+	t.Helper()
+	epoch, err := LookupEpoch(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return epoch
+}
@@ -0,0 +1,193 @@
+package parse
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DanStough/epok/leapsecond"
+)
+
+// The network-timing epochs have their own formats, rather than a count of ticks.
+var (
+	ntpEpoch = Epoch{
+		Name:    "ntp",
+		Aliases: []string{"ntp64"},
+		Description: "NTP: 32.32 fixed-point seconds since 1900-01-01, as hex like e9d5c5a6.1f9a6b50, " +
+			"the raw 64-bit value, or decimal seconds",
+		decode:            decodeNTP,
+		encode:            encodeNTP,
+		excludeCandidates: true,
+	}
+	ptpEpoch = Epoch{
+		Name:              "ptp",
+		Aliases:           []string{"ieee1588", "tai"},
+		Description:       "IEEE 1588 PTP: TAI seconds since 1970-01-01, as seconds:nanoseconds or with up to nine fractional digits",
+		decode:            decodePTP,
+		encode:            encodePTP,
+		excludeCandidates: true,
+	}
+	gpsEpoch = Epoch{
+		Name:              "gps",
+		Description:       "GPS: week:seconds-of-week, or seconds since 1980-01-06, without leap seconds",
+		decode:            decodeGPS,
+		encode:            encodeGPS,
+		excludeCandidates: true,
+	}
+)
+
+const (
+	secondsPerWeek = 7 * 24 * 60 * 60
+	// maxPTPSeconds is the range of the 48-bit seconds field of a PTP timestamp.
+	maxPTPSeconds = 1 << 48
+)
+
+var (
+	// epoch1900 is the origin of NTP timestamps.
+	epoch1900 = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+	// gpsOrigin is the GPS epoch, 1980-01-06 00:00:00 UTC, as a TAI instant.
+	gpsOrigin = leapsecond.ToTAI(time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC))
+
+	// ntpHex matches the seconds.fraction hex format of ntpq and Wireshark.
+	ntpHex = regexp.MustCompile(`^(?i)(?:0x)?([0-9a-f]{8})\.([0-9a-f]{8})$`)
+	// ntpRaw matches the whole 64-bit value in hex.
+	ntpRaw = regexp.MustCompile(`^(?i)0x([0-9a-f]{1,16})$`)
+
+	twoTo32 = new(big.Rat).SetInt64(1 << 32)
+)
+
+// decodeNTP reads a 64-bit NTP timestamp. Seconds wrap every 136 years, so following RFC 4330, values
+// with the high bit set are in era 0 (1968-2036) and the rest are in era 1 (2036-2104).
+func decodeNTP(s string) (time.Time, error) {
+	seconds, err := ntpSeconds(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if seconds.Sign() < 0 || seconds.Cmp(twoTo32) >= 0 {
+		return time.Time{}, fmt.Errorf("%w: NTP seconds must fit in 32 bits", ErrInvalidFormat)
+	}
+	if seconds.Cmp(big.NewRat(1<<31, 1)) < 0 {
+		seconds.Add(seconds, twoTo32)
+	}
+
+	nanos := seconds.Mul(seconds, new(big.Rat).SetInt(nanosPerSecond))
+	return addNanos(epoch1900, nanos)
+}
+
+// ntpSeconds reads the seconds, with their fraction, within an NTP era.
+func ntpSeconds(s string) (*big.Rat, error) {
+	var fixed uint64
+	switch {
+	case ntpHex.MatchString(s):
+		parts := ntpHex.FindStringSubmatch(s)
+		seconds, _ := strconv.ParseUint(parts[1], 16, 32)
+		fraction, _ := strconv.ParseUint(parts[2], 16, 32)
+		fixed = seconds<<32 | fraction
+	case ntpRaw.MatchString(s):
+		fixed, _ = strconv.ParseUint(ntpRaw.FindStringSubmatch(s)[1], 16, 64)
+	default:
+		seconds, err := parseRat(s)
+		if err != nil {
+			return nil, err
+		}
+		if !seconds.IsInt() || seconds.Cmp(twoTo32) < 0 {
+			return seconds, nil
+		}
+		// Integers too large for the seconds are the raw 64-bit value.
+		fixed, err = strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: NTP timestamps are 64 bits", ErrInvalidFormat)
+		}
+	}
+	r := new(big.Rat).SetInt(new(big.Int).SetUint64(fixed))
+	return r.Quo(r, twoTo32), nil
+}
+
+// encodeNTP writes the seconds.fraction hex format, rounding the fraction up so it decodes to the same
+// nanosecond.
+func encodeNTP(t time.Time) string {
+	seconds := uint32(t.Unix() - epoch1900.Unix())
+	fraction := (uint64(t.Nanosecond())<<32 + uint64(time.Second) - 1) / uint64(time.Second)
+	return fmt.Sprintf("%08x.%08x", seconds, fraction)
+}
+
+// decodePTP reads a PTP timestamp, which counts TAI rather than UTC. It's either the 48-bit seconds and
+// 32-bit nanoseconds fields of IEEE 1588, as seconds:nanoseconds, or decimal seconds.
+func decodePTP(s string) (time.Time, error) {
+	var nanos *big.Rat
+	if secs, fraction, found := strings.Cut(s, ":"); found {
+		seconds, err := strconv.ParseUint(secs, 10, 48)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: PTP seconds must fit in 48 bits, got %q", ErrInvalidFormat, secs)
+		}
+		n, err := strconv.ParseUint(fraction, 10, 32)
+		if err != nil || n >= uint64(time.Second) {
+			return time.Time{}, fmt.Errorf("%w: PTP nanoseconds must be less than %d, got %q", ErrInvalidFormat, time.Second, fraction)
+		}
+		total := new(big.Int).Mul(new(big.Int).SetUint64(seconds), nanosPerSecond)
+		nanos = new(big.Rat).SetInt(total.Add(total, new(big.Int).SetUint64(n)))
+	} else {
+		seconds, err := parseRat(s)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if seconds.Sign() < 0 || seconds.Cmp(big.NewRat(maxPTPSeconds, 1)) >= 0 {
+			return time.Time{}, fmt.Errorf("%w: PTP seconds must fit in 48 bits", ErrInvalidFormat)
+		}
+		nanos = seconds.Mul(seconds, new(big.Rat).SetInt(nanosPerSecond))
+	}
+
+	tai, err := addNanos(time.Unix(0, 0), nanos)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return leapsecond.FromTAI(tai), nil
+}
+
+func encodePTP(t time.Time) string {
+	nanos := new(big.Rat).SetInt(sinceNanos(time.Unix(0, 0), leapsecond.ToTAI(t)))
+	return formatRat(nanos.Quo(nanos, new(big.Rat).SetInt(nanosPerSecond)))
+}
+
+// decodeGPS reads GPS time, either as week:seconds-of-week or as seconds since the GPS epoch.
+// Week numbers are full, rather than the 10-bit number broadcast by satellites.
+func decodeGPS(s string) (time.Time, error) {
+	var seconds *big.Rat
+	if week, tow, found := strings.Cut(s, ":"); found {
+		w, err := strconv.ParseUint(week, 10, 32)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: invalid GPS week %q", ErrInvalidFormat, week)
+		}
+		seconds, err = parseRat(tow)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if seconds.Sign() < 0 || seconds.Cmp(big.NewRat(secondsPerWeek, 1)) >= 0 {
+			return time.Time{}, fmt.Errorf("%w: GPS time of week must be less than %d seconds", ErrInvalidFormat, secondsPerWeek)
+		}
+		seconds.Add(seconds, new(big.Rat).SetInt64(int64(w)*secondsPerWeek))
+	} else {
+		var err error
+		seconds, err = parseRat(s)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	tai, err := addNanos(gpsOrigin, seconds.Mul(seconds, new(big.Rat).SetInt(nanosPerSecond)))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return leapsecond.FromTAI(tai), nil
+}
+
+// encodeGPS writes the week:seconds-of-week format.
+func encodeGPS(t time.Time) string {
+	nanos := sinceNanos(gpsOrigin, leapsecond.ToTAI(t))
+	week, tow := new(big.Int).DivMod(nanos, big.NewInt(secondsPerWeek*int64(time.Second)), new(big.Int))
+	seconds := new(big.Rat).SetFrac(tow, nanosPerSecond)
+	return week.String() + ":" + formatRat(seconds)
+}
@@ -0,0 +1,91 @@
+package tz
+
+import "time"
+
+// abbreviation is a meaning of a time zone abbreviation.
+type abbreviation struct {
+	name   string // name is the full name, like "Pacific Standard Time".
+	offset int    // offset is in seconds east of UTC.
+	zone   string // zone is a zone that uses the abbreviation, like "America/Los_Angeles".
+
+	// generic abbreviations, like "PT", mean the zone instead of a fixed offset, so they follow its
+	// daylight saving time.
+	generic bool
+}
+
+const hour = int(time.Hour / time.Second)
+
+// abbreviations are the common abbreviations of zones. Some of them mean more than one zone, like IST
+// in India, Ireland and Israel, so they can't be used as specifiers.
+var abbreviations = map[string][]abbreviation{
+	// North America
+	"PT":   {{name: "Pacific Time", zone: "America/Los_Angeles", generic: true}},
+	"MT":   {{name: "Mountain Time", zone: "America/Denver", generic: true}},
+	"CT":   {{name: "Central Time", zone: "America/Chicago", generic: true}},
+	"ET":   {{name: "Eastern Time", zone: "America/New_York", generic: true}},
+	"PST":  {{name: "Pacific Standard Time", offset: -8 * hour, zone: "America/Los_Angeles"}},
+	"PDT":  {{name: "Pacific Daylight Time", offset: -7 * hour, zone: "America/Los_Angeles"}},
+	"MST":  {{name: "Mountain Standard Time", offset: -7 * hour, zone: "America/Denver"}},
+	"MDT":  {{name: "Mountain Daylight Time", offset: -6 * hour, zone: "America/Denver"}},
+	"CDT":  {{name: "Central Daylight Time", offset: -5 * hour, zone: "America/Chicago"}},
+	"EST":  {{name: "Eastern Standard Time", offset: -5 * hour, zone: "America/New_York"}},
+	"EDT":  {{name: "Eastern Daylight Time", offset: -4 * hour, zone: "America/New_York"}},
+	"ADT":  {{name: "Atlantic Daylight Time", offset: -3 * hour, zone: "America/Halifax"}},
+	"NST":  {{name: "Newfoundland Standard Time", offset: -7 * hour / 2, zone: "America/St_Johns"}},
+	"NDT":  {{name: "Newfoundland Daylight Time", offset: -5 * hour / 2, zone: "America/St_Johns"}},
+	"AKST": {{name: "Alaska Standard Time", offset: -9 * hour, zone: "America/Anchorage"}},
+	"AKDT": {{name: "Alaska Daylight Time", offset: -8 * hour, zone: "America/Anchorage"}},
+	"HST":  {{name: "Hawaii Standard Time", offset: -10 * hour, zone: "Pacific/Honolulu"}},
+	"CST": {
+		{name: "Central Standard Time", offset: -6 * hour, zone: "America/Chicago"},
+		{name: "China Standard Time", offset: 8 * hour, zone: "Asia/Shanghai"},
+		{name: "Cuba Standard Time", offset: -5 * hour, zone: "America/Havana"},
+	},
+	"AST": {
+		{name: "Atlantic Standard Time", offset: -4 * hour, zone: "America/Halifax"},
+		{name: "Arabia Standard Time", offset: 3 * hour, zone: "Asia/Riyadh"},
+	},
+
+	// South America
+	"ART": {{name: "Argentina Time", offset: -3 * hour, zone: "America/Argentina/Buenos_Aires"}},
+	"BRT": {{name: "Brasília Time", offset: -3 * hour, zone: "America/Sao_Paulo"}},
+
+	// Europe and Africa
+	"WET":  {{name: "Western European Time", offset: 0, zone: "Europe/Lisbon"}},
+	"WEST": {{name: "Western European Summer Time", offset: hour, zone: "Europe/Lisbon"}},
+	"CET":  {{name: "Central European Time", offset: hour, zone: "Europe/Paris"}},
+	"CEST": {{name: "Central European Summer Time", offset: 2 * hour, zone: "Europe/Paris"}},
+	"EET":  {{name: "Eastern European Time", offset: 2 * hour, zone: "Europe/Athens"}},
+	"EEST": {{name: "Eastern European Summer Time", offset: 3 * hour, zone: "Europe/Athens"}},
+	"MSK":  {{name: "Moscow Time", offset: 3 * hour, zone: "Europe/Moscow"}},
+	"WAT":  {{name: "West Africa Time", offset: hour, zone: "Africa/Lagos"}},
+	"CAT":  {{name: "Central Africa Time", offset: 2 * hour, zone: "Africa/Maputo"}},
+	"SAST": {{name: "South Africa Standard Time", offset: 2 * hour, zone: "Africa/Johannesburg"}},
+	"EAT":  {{name: "East Africa Time", offset: 3 * hour, zone: "Africa/Nairobi"}},
+	"BST": {
+		{name: "British Summer Time", offset: hour, zone: "Europe/London"},
+		{name: "Bangladesh Standard Time", offset: 6 * hour, zone: "Asia/Dhaka"},
+	},
+	"IST": {
+		{name: "India Standard Time", offset: 11 * hour / 2, zone: "Asia/Kolkata"},
+		{name: "Irish Standard Time", offset: hour, zone: "Europe/Dublin"},
+		{name: "Israel Standard Time", offset: 2 * hour, zone: "Asia/Jerusalem"},
+	},
+
+	// Asia and Oceania
+	"GST":  {{name: "Gulf Standard Time", offset: 4 * hour, zone: "Asia/Dubai"}},
+	"PKT":  {{name: "Pakistan Standard Time", offset: 5 * hour, zone: "Asia/Karachi"}},
+	"ICT":  {{name: "Indochina Time", offset: 7 * hour, zone: "Asia/Bangkok"}},
+	"WIB":  {{name: "Western Indonesia Time", offset: 7 * hour, zone: "Asia/Jakarta"}},
+	"HKT":  {{name: "Hong Kong Time", offset: 8 * hour, zone: "Asia/Hong_Kong"}},
+	"SGT":  {{name: "Singapore Time", offset: 8 * hour, zone: "Asia/Singapore"}},
+	"AWST": {{name: "Australian Western Standard Time", offset: 8 * hour, zone: "Australia/Perth"}},
+	"JST":  {{name: "Japan Standard Time", offset: 9 * hour, zone: "Asia/Tokyo"}},
+	"KST":  {{name: "Korea Standard Time", offset: 9 * hour, zone: "Asia/Seoul"}},
+	"ACST": {{name: "Australian Central Standard Time", offset: 19 * hour / 2, zone: "Australia/Adelaide"}},
+	"ACDT": {{name: "Australian Central Daylight Time", offset: 21 * hour / 2, zone: "Australia/Adelaide"}},
+	"AEST": {{name: "Australian Eastern Standard Time", offset: 10 * hour, zone: "Australia/Sydney"}},
+	"AEDT": {{name: "Australian Eastern Daylight Time", offset: 11 * hour, zone: "Australia/Sydney"}},
+	"NZST": {{name: "New Zealand Standard Time", offset: 12 * hour, zone: "Pacific/Auckland"}},
+	"NZDT": {{name: "New Zealand Daylight Time", offset: 13 * hour, zone: "Pacific/Auckland"}},
+}
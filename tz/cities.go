@@ -0,0 +1,34 @@
+package tz
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+// city is a city in the index, and the zone it's in.
+type city struct {
+	Name    string
+	Country string // Country is the ISO 3166 code, like "US".
+	Zone    string
+}
+
+//go:embed cities.tsv
+var citiesTSV string
+
+// cities are the major cities that aren't the namesake of a zone, like San Francisco, so searching for them
+// finds the zone they're in. Cities like New York or Tokyo are already found from the zone names.
+var cities = sync.OnceValue(func() []city {
+	var index []city
+	for _, line := range strings.Split(citiesTSV, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 {
+			panic("tz: malformed line in the city index: " + line)
+		}
+		index = append(index, city{Name: fields[0], Country: fields[1], Zone: fields[2]})
+	}
+	return index
+})
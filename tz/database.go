@@ -0,0 +1,202 @@
+package tz
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+var ErrNoDatabase = errors.New("time zone database not found")
+
+// zoneDirs are where Unix systems install the database, in the order the time package searches them.
+var zoneDirs = []string{
+	"/usr/share/zoneinfo/",
+	"/usr/share/lib/zoneinfo/",
+	"/usr/lib/locale/TZ/",
+	"/etc/zoneinfo/",
+}
+
+// Database is a copy of the time zone database, either a directory of zone files or a zip file like the
+// one shipped with Go.
+type Database struct {
+	Path string
+
+	// Source is how the database was found: "ZONEINFO" when it's set by that environment variable,
+	// "system" for a directory installed by the OS, "GOROOT" for the copy shipped with Go, "embedded" for
+	// the time/tzdata package built into epok, or "custom" when it was opened with OpenDatabase.
+	Source string
+}
+
+// Embedded is the source of the database built into epok.
+const Embedded = "embedded"
+
+// OpenDatabase checks that path is a database, either a directory of zone files or a zip file.
+func OpenDatabase(path string) (Database, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return Database{}, err
+	}
+	if !info.IsDir() && !strings.HasSuffix(path, ".zip") {
+		return Database{}, fmt.Errorf("%s is not a directory or a zip file", path)
+	}
+	return Database{Path: path, Source: "custom"}, nil
+}
+
+// FindDatabase returns the first database the time package would load zones from. It doesn't include the
+// embedded database, which LoadLocation falls back to.
+func FindDatabase() (Database, error) {
+	if path := os.Getenv("ZONEINFO"); path != "" {
+		if _, err := os.Stat(path); err == nil {
+			return Database{Path: path, Source: "ZONEINFO"}, nil
+		}
+	}
+	for _, dir := range zoneDirs {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return Database{Path: filepath.Clean(dir), Source: "system"}, nil
+		}
+	}
+	// The time package also falls back to the zip file in GOROOT.
+	path := filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip")
+	if _, err := os.Stat(path); err == nil {
+		return Database{Path: path, Source: "GOROOT"}, nil
+	}
+	return Database{}, ErrNoDatabase
+}
+
+// Names lists every zone in the database, including the backward-compatible aliases like "US/Eastern",
+// sorted by name.
+func (db Database) Names() ([]string, error) {
+	switch {
+	case db.Path == "":
+		return nil, ErrNoDatabase
+	case db.Source == Embedded:
+		// time/tzdata only loads zones by name, so its zones are recorded by gen_tzdata.go.
+		var names []string
+		for _, name := range embeddedNames {
+			if isZoneName(name) {
+				names = append(names, name)
+			}
+		}
+		return names, nil
+	}
+
+	var names []string
+	if db.isZip() {
+		r, closer, err := db.openZip()
+		if err != nil {
+			return nil, err
+		}
+		defer closer.Close()
+		names = zipNames(r)
+	} else {
+		var err error
+		if names, err = dirNames(db.Path); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// isZip reports whether the database is a zip file rather than a directory.
+func (db Database) isZip() bool {
+	return strings.HasSuffix(db.Path, ".zip")
+}
+
+// openZip opens a zip database.
+func (db Database) openZip() (*zip.Reader, io.Closer, error) {
+	rc, err := zip.OpenReader(db.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &rc.Reader, rc, nil
+}
+
+// Names lists every zone in the database in use.
+func Names() ([]string, error) {
+	return Current().Names()
+}
+
+// dirNames walks a zoneinfo directory for zone files.
+func dirNames(root string) ([]string, error) {
+	var names []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+
+		if d.IsDir() {
+			// posix and right are copies of the database, with and without leap seconds.
+			if name == "posix" || name == "right" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !isZoneName(name) {
+			return nil
+		}
+
+		// Zone files start with a magic number, which skips tables like zone.tab.
+		f, err := os.Open(path)
+		if err != nil {
+			return nil
+		}
+		defer f.Close()
+		magic := make([]byte, 4)
+		if _, err := io.ReadFull(f, magic); err == nil && bytes.Equal(magic, []byte("TZif")) {
+			names = append(names, name)
+		}
+		return nil
+	})
+	return names, err
+}
+
+// zipNames lists the zones in a zip file, where every file is a zone.
+func zipNames(r *zip.Reader) []string {
+	var names []string
+	for _, f := range r.File {
+		if !f.FileInfo().IsDir() && isZoneName(f.Name) {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
+
+// isZoneName skips the files in a database that aren't zones people choose, like the link to the
+// system's zone.
+func isZoneName(name string) bool {
+	switch name {
+	case "localtime", "posixrules", "Factory":
+		return false
+	}
+	return name != "" && 'A' <= name[0] && name[0] <= 'Z'
+}
+
+// Region returns the area of a zone name, like "America" for "America/Argentina/Salta". Names without an
+// area, like "UTC" or "EST5EDT", have no region.
+func Region(name string) string {
+	region, _, found := strings.Cut(name, "/")
+	if !found {
+		return ""
+	}
+	return region
+}
+
+// City returns the location of a zone name, like "Salta" for "America/Argentina/Salta" or "New York" for
+// "America/New_York".
+func City(name string) string {
+	return strings.ReplaceAll(name[strings.LastIndex(name, "/")+1:], "_", " ")
+}
@@ -0,0 +1,145 @@
+package tz
+
+import (
+	"archive/zip"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestDatabaseNames(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"America/New_York":        "TZif2",
+		"America/Argentina/Salta": "TZif2",
+		"UTC":                     "TZif2",
+		"zone.tab":                "# tz zone descriptions",
+		"tzdata.zi":               "# version 2025b",
+		"posix/America/New_York":  "TZif2",
+		"right/UTC":               "TZif2",
+		"localtime":               "TZif2",
+		"Factory":                 "TZif2",
+		"Europe/NotAZoneFile":     "hello",
+	}
+	for name, contents := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	archive := filepath.Join(t.TempDir(), "zoneinfo.zip")
+	f, err := os.Create(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	for _, name := range []string{"UTC", "Europe/London", "Asia/Tokyo"} {
+		if _, err := zw.Create(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		db       Database
+		expected []string
+		err      error
+	}{
+		{
+			name:     "directory",
+			db:       Database{Path: root},
+			expected: []string{"America/Argentina/Salta", "America/New_York", "UTC"},
+		},
+		{
+			name:     "zip",
+			db:       Database{Path: archive},
+			expected: []string{"Asia/Tokyo", "Europe/London", "UTC"},
+		},
+		{name: "no database", db: Database{}, err: ErrNoDatabase},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.db.Names()
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Errorf("Names() error = %v, expected %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("Names() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEmbeddedNames(t *testing.T) {
+	names, err := embeddedDatabase.Names()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sort.StringsAreSorted(names) {
+		t.Error("Names() isn't sorted")
+	}
+	for _, name := range []string{"America/New_York", "Asia/Tokyo", "Europe/London", "UTC"} {
+		if i := sort.SearchStrings(names, name); i == len(names) || names[i] != name {
+			t.Errorf("Names() doesn't include %s", name)
+		}
+	}
+	if i := sort.SearchStrings(names, "Factory"); i < len(names) && names[i] == "Factory" {
+		t.Error("Names() includes Factory")
+	}
+}
+
+func TestFindDatabase(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv("ZONEINFO", root)
+
+	db, err := FindDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.Path != root || db.Source != "ZONEINFO" {
+		t.Errorf("FindDatabase() = %+v, expected %s from ZONEINFO", db, root)
+	}
+}
+
+func TestRegionAndCity(t *testing.T) {
+	tests := []struct {
+		name   string
+		region string
+		city   string
+	}{
+		{name: "America/New_York", region: "America", city: "New York"},
+		{name: "America/Argentina/Salta", region: "America", city: "Salta"},
+		{name: "UTC", region: "", city: "UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := Region(tt.name); actual != tt.region {
+				t.Errorf("Region() = %q, expected %q", actual, tt.region)
+			}
+			if actual := City(tt.name); actual != tt.city {
+				t.Errorf("City() = %q, expected %q", actual, tt.city)
+			}
+		})
+	}
+}
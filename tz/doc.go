@@ -0,0 +1,9 @@
+// Package tz is a module for exploring the IANA time zone database: listing and searching the zones it
+// contains, finding which zone the system is using and where that came from, and finding the upcoming
+// transitions of a zone, like the start and end of daylight saving time. Resolve also finds the zone a
+// user means by an offset, abbreviation, city or POSIX TZ string, not only by its IANA name.
+//
+// The time package can load a zone by name, but it can't list the zones that exist or report the
+// transitions of a location, so those are read from the database files and found with
+// time.Time.ZoneBounds.
+package tz
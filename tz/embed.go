@@ -0,0 +1,7 @@
+//go:build timetzdata
+
+package tz
+
+// embedded reports whether the time/tzdata package is built into epok. The time package imports it with the
+// timetzdata build tag, and falls back to it when the system doesn't have a database.
+const embedded = true
@@ -0,0 +1,85 @@
+//go:build ignore
+
+// gen_tzdata writes tzdata.go, which records the release and zones of the time/tzdata package built into
+// epok. time/tzdata is generated from the zip shipped with Go, so both are read from there:
+//
+//	go generate ./tz
+//
+// Run it with the Go release epok is built with, after upgrading it.
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+func main() {
+	dir := filepath.Join(runtime.GOROOT(), "lib", "time")
+
+	version, err := release(filepath.Join(dir, "update.bash"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	r, err := zip.OpenReader(filepath.Join(dir, "zoneinfo.zip"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+	var names []string
+	for _, f := range r.File {
+		if !f.FileInfo().IsDir() {
+			names = append(names, f.Name)
+		}
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_tzdata.go from %s; DO NOT EDIT.\n\n", runtime.Version())
+	fmt.Fprintf(&buf, "package tz\n\n")
+	fmt.Fprintf(&buf, "// embeddedGo is the Go release the embedded database was read from.\n")
+	fmt.Fprintf(&buf, "const embeddedGo = %q\n\n", runtime.Version())
+	fmt.Fprintf(&buf, "// embeddedVersion is the tzdata release of the embedded database.\n")
+	fmt.Fprintf(&buf, "const embeddedVersion = %q\n\n", version)
+	fmt.Fprintf(&buf, "// embeddedNames are the zones in the embedded database, sorted by name.\n")
+	fmt.Fprintf(&buf, "var embeddedNames = []string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q,\n", name)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("tzdata.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// release reads the tzdata release from the script that builds the zip, from a line like "DATA=2025b".
+func release(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if version, found := strings.CutPrefix(scanner.Text(), "DATA="); found {
+			return strings.TrimSpace(version), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no DATA= line in %s", path)
+}
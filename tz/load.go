@@ -0,0 +1,146 @@
+package tz
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:generate go run gen_tzdata.go
+
+// embeddedDatabase is the time/tzdata package, which is built into epok with the timetzdata build tag.
+var embeddedDatabase = Database{Path: "time/tzdata", Source: Embedded}
+
+// database is the database chosen with Use. When it's unset, zones are loaded like the time package does.
+var database struct {
+	sync.RWMutex
+	db *Database
+}
+
+// Use loads every zone from db, or from the databases the time package searches when db is nil.
+func Use(db *Database) {
+	database.Lock()
+	defer database.Unlock()
+	database.db = db
+}
+
+// Current returns the database zones are loaded from: the one chosen with Use, the first one the time
+// package would search, or the embedded database when the system doesn't have one. It's the zero Database
+// when there isn't any.
+func Current() Database {
+	database.RLock()
+	defer database.RUnlock()
+	if database.db != nil {
+		return *database.db
+	}
+	if db, err := FindDatabase(); err == nil {
+		return db
+	}
+	if embedded {
+		return embeddedDatabase
+	}
+	return Database{}
+}
+
+// LoadLocation loads a zone by name, like time.LoadLocation, from the database chosen with Use. Without
+// one, the time package searches the system and then the embedded database.
+func LoadLocation(name string) (*time.Location, error) {
+	database.RLock()
+	db := database.db
+	database.RUnlock()
+	if db != nil {
+		return db.Load(name)
+	}
+	return time.LoadLocation(name)
+}
+
+// Load loads a zone by name from the database. Like time.LoadLocation, "" and "UTC" are UTC, and "Local"
+// is the system zone.
+func (db Database) Load(name string) (*time.Location, error) {
+	switch name {
+	case "", "UTC":
+		return time.UTC, nil
+	case "Local":
+		return time.Local, nil
+	}
+	// The embedded database is only used when the system doesn't have one, so the time package loads it.
+	if db.Source == Embedded {
+		return time.LoadLocation(name)
+	}
+	// Names are paths into the database, so they can't leave it.
+	if !fs.ValidPath(name) || strings.Contains(name, `\`) {
+		return nil, errors.New("invalid time zone " + name)
+	}
+
+	data, err := db.read(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errors.New("unknown time zone " + name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load time zone %s from %s: %w", name, db.Path, err)
+	}
+	return time.LoadLocationFromTZData(name, data)
+}
+
+// read reads a file from the database.
+func (db Database) read(name string) ([]byte, error) {
+	if !db.isZip() {
+		return os.ReadFile(filepath.Join(db.Path, filepath.FromSlash(name)))
+	}
+
+	r, closer, err := db.openZip()
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	f, err := r.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// Version returns the tzdata release of the database, like "2025b", or "" when it can't be found. System
+// databases include it in tzdata.zi or +VERSION, and the zip shipped with Go is versioned by the script that
+// builds it. The embedded database doesn't include its release, so it's recorded by gen_tzdata.go, and is
+// only known when epok is built with the Go release it was generated from.
+func (db Database) Version() string {
+	if db.Source == Embedded {
+		if runtime.Version() != embeddedGo {
+			return ""
+		}
+		return embeddedVersion
+	}
+	if data, err := db.read("tzdata.zi"); err == nil {
+		// The first line is like "# version 2025b".
+		line, _, _ := strings.Cut(string(data), "\n")
+		if version, found := strings.CutPrefix(line, "# version "); found {
+			return strings.TrimSpace(version)
+		}
+	}
+	if data, err := db.read("+VERSION"); err == nil {
+		return strings.TrimSpace(string(data))
+	}
+
+	if db.isZip() {
+		if f, err := os.Open(filepath.Join(filepath.Dir(db.Path), "update.bash")); err == nil {
+			defer f.Close()
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				if version, found := strings.CutPrefix(scanner.Text(), "DATA="); found {
+					return strings.TrimSpace(version)
+				}
+			}
+		}
+	}
+	return ""
+}
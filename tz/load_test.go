@@ -0,0 +1,202 @@
+package tz
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// goDatabase is the zip shipped with Go, which the tests copy zones from.
+var goDatabase = Database{Path: filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip")}
+
+// zoneinfoZip builds a zip like the one shipped with Go, which doesn't include its release.
+func zoneinfoZip(t *testing.T) string {
+	t.Helper()
+
+	data, err := goDatabase.read("UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// writeDatabase copies zones from the zip shipped with Go into a directory, with extra files like tzdata.zi.
+func writeDatabase(t *testing.T, zones []string, files map[string]string) string {
+	t.Helper()
+
+	root := t.TempDir()
+	for _, name := range zones {
+		data, err := goDatabase.read(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[name] = string(data)
+	}
+	for name, contents := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestOpenDatabase(t *testing.T) {
+	root := t.TempDir()
+	file := filepath.Join(root, "zoneinfo.txt")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected Database
+		err      string
+	}{
+		{name: "directory", path: root, expected: Database{Path: root, Source: "custom"}},
+		{name: "missing", path: filepath.Join(root, "missing"), err: "stat " + filepath.Join(root, "missing") + ": no such file or directory"},
+		{name: "not a database", path: file, err: file + " is not a directory or a zip file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := OpenDatabase(tt.path)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Errorf("OpenDatabase() error = %v, expected %s", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != tt.expected {
+				t.Errorf("OpenDatabase() = %+v, expected %+v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDatabaseLoad(t *testing.T) {
+	root := writeDatabase(t, []string{"Asia/Tokyo"}, map[string]string{})
+	january := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		db     Database
+		zone   string
+		offset int
+		err    string
+	}{
+		{name: "zip", db: goDatabase, zone: "America/New_York", offset: -5 * 3600},
+		{name: "directory", db: Database{Path: root}, zone: "Asia/Tokyo", offset: 9 * 3600},
+		{name: "UTC isn't a file", db: Database{Path: root}, zone: "UTC"},
+		{name: "unknown zone", db: Database{Path: root}, zone: "America/New_York", err: "unknown time zone America/New_York"},
+		{name: "outside the database", db: Database{Path: root}, zone: "../Asia/Tokyo", err: "invalid time zone ../Asia/Tokyo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := tt.db.Load(tt.zone)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Errorf("Load() error = %v, expected %s", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if loc.String() != tt.zone {
+				t.Errorf("Load() = %s, expected %s", loc, tt.zone)
+			}
+			if _, offset := january.In(loc).Zone(); offset != tt.offset {
+				t.Errorf("Load() offset = %d, expected %d", offset, tt.offset)
+			}
+		})
+	}
+}
+
+func TestDatabaseVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		db       Database
+		expected string
+	}{
+		{
+			name:     "tzdata.zi",
+			db:       Database{Path: writeDatabase(t, nil, map[string]string{"tzdata.zi": "# version 2025b\n# redo posix_only\n"})},
+			expected: "2025b",
+		},
+		{
+			name:     "+VERSION",
+			db:       Database{Path: writeDatabase(t, nil, map[string]string{"+VERSION": "2024a\n"})},
+			expected: "2024a",
+		},
+		{
+			name:     "zip shipped with Go",
+			db:       Database{Path: filepath.Join(writeDatabase(t, nil, map[string]string{"update.bash": "CODE=2025a\nDATA=2025a\n", "zoneinfo.zip": zoneinfoZip(t)}), "zoneinfo.zip")},
+			expected: "2025a",
+		},
+		{name: "unknown", db: Database{Path: writeDatabase(t, []string{"UTC"}, map[string]string{})}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := tt.db.Version(); actual != tt.expected {
+				t.Errorf("Version() = %q, expected %q", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEmbeddedVersion(t *testing.T) {
+	// tzdata.go records the release of the Go it was generated from, which other releases may not embed.
+	if runtime.Version() != embeddedGo {
+		if actual := embeddedDatabase.Version(); actual != "" {
+			t.Errorf("Version() = %q with %s, expected none", actual, runtime.Version())
+		}
+		t.Skipf("tzdata.go was generated from %s", embeddedGo)
+	}
+	if actual, expected := embeddedDatabase.Version(), goDatabase.Version(); actual != expected {
+		t.Errorf("Version() = %q, expected %q", actual, expected)
+	}
+}
+
+func TestUse(t *testing.T) {
+	root := writeDatabase(t, []string{"Asia/Tokyo"}, map[string]string{"+VERSION": "2024a"})
+	db := Database{Path: root, Source: "custom"}
+	Use(&db)
+	t.Cleanup(func() { Use(nil) })
+
+	if actual := Current(); actual != db {
+		t.Errorf("Current() = %+v, expected %+v", actual, db)
+	}
+	if _, err := LoadLocation("Asia/Tokyo"); err != nil {
+		t.Errorf("LoadLocation() error = %v", err)
+	}
+	// Zones the system has aren't loaded from other databases.
+	if _, err := LoadLocation("Europe/London"); err == nil {
+		t.Error("LoadLocation() loaded a zone that isn't in the database")
+	}
+}
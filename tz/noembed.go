@@ -0,0 +1,6 @@
+//go:build !timetzdata
+
+package tz
+
+// embedded reports whether the time/tzdata package is built into epok, which needs the timetzdata build tag.
+const embedded = false
@@ -0,0 +1,203 @@
+package tz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidPOSIX is returned for POSIX TZ strings that can't be parsed.
+var ErrInvalidPOSIX = errors.New("invalid POSIX TZ string")
+
+// loadPOSIX loads a POSIX TZ string, like "EST5EDT,M3.2.0,M11.1.0" or "<+0530>-5:30". The time package can
+// only read these rules from the footer of a zone file, so they're wrapped in one without any transitions.
+func loadPOSIX(spec string) (*time.Location, error) {
+	std, offset, err := parsePOSIX(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	// Version 2 files have a version 1 header and body for old readers, and then the same again, followed
+	// by the footer.
+	for range 2 {
+		b.WriteString("TZif2")
+		b.Write(make([]byte, 15))
+		// The counts of UT/local indicators, standard/wall indicators, leap seconds, transitions, local time
+		// types and abbreviation bytes.
+		for _, n := range []uint32{0, 0, 0, 0, 1, uint32(len(std) + 1)} {
+			_ = binary.Write(&b, binary.BigEndian, n)
+		}
+		_ = binary.Write(&b, binary.BigEndian, int32(offset))
+		b.WriteByte(0) // isdst
+		b.WriteByte(0) // abbreviation index
+		b.WriteString(std)
+		b.WriteByte(0)
+	}
+	b.WriteString("\n" + spec + "\n")
+
+	return time.LoadLocationFromTZData(spec, b.Bytes())
+}
+
+// parsePOSIX validates a POSIX TZ string, returning the abbreviation and offset of standard time. The
+// offset is in seconds east of UTC, the opposite of the string, which counts west.
+func parsePOSIX(spec string) (std string, offset int, err error) {
+	p := &posixParser{s: spec}
+	invalid := func(reason string) (string, int, error) {
+		return "", 0, fmt.Errorf("%w %q: %s", ErrInvalidPOSIX, spec, reason)
+	}
+
+	std, ok := p.name()
+	if !ok {
+		return invalid("expected a standard time abbreviation")
+	}
+	west, ok := p.offset(24)
+	if !ok {
+		return invalid("expected a standard time offset")
+	}
+	if p.done() {
+		return std, -west, nil
+	}
+
+	if _, ok := p.name(); !ok {
+		return invalid("expected a daylight saving time abbreviation")
+	}
+	if !p.done() && p.s[0] != ',' {
+		if _, ok := p.offset(24); !ok {
+			return invalid("expected a daylight saving time offset")
+		}
+	}
+	if p.done() {
+		return std, -west, nil
+	}
+
+	for _, which := range []string{"start", "end"} {
+		if !p.consume(',') {
+			return invalid("expected a comma before the " + which + " rule")
+		}
+		if !p.rule() {
+			return invalid("invalid " + which + " rule")
+		}
+		if p.consume('/') {
+			if _, ok := p.offset(167); !ok {
+				return invalid("invalid time of the " + which + " rule")
+			}
+		}
+	}
+	if !p.done() {
+		return invalid("unexpected " + strconv.Quote(p.s))
+	}
+	return std, -west, nil
+}
+
+// posixParser consumes a POSIX TZ string from the front.
+type posixParser struct {
+	s string
+}
+
+func (p *posixParser) done() bool {
+	return p.s == ""
+}
+
+func (p *posixParser) consume(c byte) bool {
+	if p.s != "" && p.s[0] == c {
+		p.s = p.s[1:]
+		return true
+	}
+	return false
+}
+
+// name reads an abbreviation of at least three letters, or any characters quoted in angle brackets like
+// "<+0530>".
+func (p *posixParser) name() (string, bool) {
+	if p.consume('<') {
+		end := strings.IndexByte(p.s, '>')
+		if end < 3 {
+			return "", false
+		}
+		name := p.s[:end]
+		p.s = p.s[end+1:]
+		return name, true
+	}
+
+	n := 0
+	for n < len(p.s) && (p.s[n] >= 'a' && p.s[n] <= 'z' || p.s[n] >= 'A' && p.s[n] <= 'Z') {
+		n++
+	}
+	if n < 3 {
+		return "", false
+	}
+	name := p.s[:n]
+	p.s = p.s[n:]
+	return name, true
+}
+
+// offset reads a signed "hh[:mm[:ss]]" in seconds, with at most maxHours hours.
+func (p *posixParser) offset(maxHours int) (int, bool) {
+	sign := 1
+	if p.consume('-') {
+		sign = -1
+	} else {
+		p.consume('+')
+	}
+
+	hours, ok := p.number(0, maxHours)
+	if !ok {
+		return 0, false
+	}
+	seconds := hours * 3600
+	for _, scale := range []int{60, 1} {
+		if !p.consume(':') {
+			break
+		}
+		n, ok := p.number(0, 59)
+		if !ok {
+			return 0, false
+		}
+		seconds += n * scale
+	}
+	return sign * seconds, true
+}
+
+// rule reads the day a transition happens on: "Jn" for the Julian day without leap days, "n" for the
+// zero-based day of the year, or "Mm.w.d" for day d of week w of month m.
+func (p *posixParser) rule() bool {
+	switch {
+	case p.consume('J'):
+		_, ok := p.number(1, 365)
+		return ok
+	case p.consume('M'):
+		if _, ok := p.number(1, 12); !ok || !p.consume('.') {
+			return false
+		}
+		if _, ok := p.number(1, 5); !ok || !p.consume('.') {
+			return false
+		}
+		_, ok := p.number(0, 6)
+		return ok
+	default:
+		_, ok := p.number(0, 365)
+		return ok
+	}
+}
+
+// number reads an unsigned number from lo to hi.
+func (p *posixParser) number(lo, hi int) (int, bool) {
+	n := 0
+	for n < len(p.s) && p.s[n] >= '0' && p.s[n] <= '9' {
+		n++
+	}
+	if n == 0 {
+		return 0, false
+	}
+	value, err := strconv.Atoi(p.s[:n])
+	if err != nil || value < lo || value > hi {
+		return 0, false
+	}
+	p.s = p.s[n:]
+	return value, true
+}
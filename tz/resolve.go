@@ -0,0 +1,150 @@
+package tz
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrUnknownZone is returned when a specifier doesn't match any zone.
+	ErrUnknownZone = errors.New("unknown time zone")
+
+	// ErrAmbiguous is returned when a specifier matches more than one zone, like the abbreviation "IST" or
+	// the city "San Jose".
+	ErrAmbiguous = errors.New("ambiguous time zone")
+)
+
+// fixedOffset matches offsets like "+05:30", "-0800", "UTC-8" or "GMT+5:30".
+var fixedOffset = regexp.MustCompile(`^(?i:utc|gmt)?([+-])(\d{1,2})(?::?(\d{2}))?(?::?(\d{2}))?$`)
+
+// Resolve finds the location a specifier names. It accepts, in order:
+//   - IANA names, like "America/New_York", "UTC" or "Local", in any case;
+//   - fixed offsets, like "Z", "+05:30", "-0800" or "UTC-8", which is eight hours behind UTC;
+//   - POSIX TZ strings, like "EST5EDT,M3.2.0,M11.1.0";
+//   - common abbreviations, like "PST" for the offset or "PT" for the zone;
+//   - cities, like "tokyo" or "San Jose, CR", from the zone names and an embedded index.
+//
+// Abbreviations and cities that mean more than one zone return ErrAmbiguous with the choices.
+func Resolve(spec string) (*time.Location, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, fmt.Errorf("%w: empty", ErrUnknownZone)
+	}
+
+	if loc, err := LoadLocation(spec); err == nil {
+		return loc, nil
+	}
+	if loc, ok := parseOffset(spec); ok {
+		return loc, nil
+	}
+	if isPOSIX(spec) {
+		return loadPOSIX(spec)
+	}
+	if meanings, ok := abbreviations[strings.ToUpper(spec)]; ok {
+		return resolveAbbreviation(strings.ToUpper(spec), meanings)
+	}
+
+	names, _ := Names()
+	for _, name := range names {
+		if strings.EqualFold(name, strings.ReplaceAll(spec, " ", "_")) {
+			return LoadLocation(name)
+		}
+	}
+	return resolveCity(spec, names)
+}
+
+// parseOffset reads a fixed offset. The zone is named after it, like "UTC+05:30".
+func parseOffset(spec string) (*time.Location, bool) {
+	if strings.EqualFold(spec, "z") {
+		return time.UTC, true
+	}
+	m := fixedOffset.FindStringSubmatch(spec)
+	if m == nil {
+		return nil, false
+	}
+	hours, _ := strconv.Atoi(m[2])
+	minutes, _ := strconv.Atoi("0" + m[3])
+	seconds, _ := strconv.Atoi("0" + m[4])
+	if hours > 18 || minutes > 59 || seconds > 59 {
+		return nil, false
+	}
+
+	offset := hours*3600 + minutes*60 + seconds
+	if m[1] == "-" {
+		offset = -offset
+	}
+	if offset == 0 {
+		return time.UTC, true
+	}
+	return time.FixedZone("UTC"+FormatOffset(offset), offset), true
+}
+
+// isPOSIX guesses whether a specifier is meant as a POSIX TZ string, which always starts with an
+// abbreviation followed by an offset. Only the rules after the first comma can have slashes, unlike zone
+// names.
+func isPOSIX(spec string) bool {
+	head, _, _ := strings.Cut(spec, ",")
+	startsWithName := spec[0] == '<' || spec[0] >= 'A' && spec[0] <= 'Z' || spec[0] >= 'a' && spec[0] <= 'z'
+	return startsWithName && strings.ContainsAny(head, "0123456789") && !strings.Contains(head, "/")
+}
+
+func resolveAbbreviation(abbr string, meanings []abbreviation) (*time.Location, error) {
+	if len(meanings) > 1 {
+		choices := make([]string, 0, len(meanings))
+		for _, meaning := range meanings {
+			choices = append(choices, fmt.Sprintf("%s %s (%s)", meaning.name, FormatOffset(meaning.offset), meaning.zone))
+		}
+		return nil, fmt.Errorf("%w %s, could be %s", ErrAmbiguous, abbr, strings.Join(choices, ", "))
+	}
+
+	meaning := meanings[0]
+	if meaning.generic {
+		return LoadLocation(meaning.zone)
+	}
+	return time.FixedZone(abbr, meaning.offset), nil
+}
+
+// resolveCity finds the zone of a city, from the index or the zone names. A country code can follow the
+// city to choose between cities with the same name, like "San Jose, CR".
+func resolveCity(spec string, names []string) (*time.Location, error) {
+	query, country, _ := strings.Cut(spec, ",")
+	query, country = normalize(query), strings.TrimSpace(country)
+
+	var zones, choices []string
+	add := func(zone, choice string) {
+		for _, z := range zones {
+			if z == zone {
+				return
+			}
+		}
+		zones = append(zones, zone)
+		choices = append(choices, choice)
+	}
+
+	for _, c := range cities() {
+		if normalize(c.Name) == query && (country == "" || strings.EqualFold(c.Country, country)) {
+			add(c.Zone, fmt.Sprintf("%s, %s (%s)", c.Name, c.Country, c.Zone))
+		}
+	}
+	// Zone names don't have a country, so they only match without one.
+	if country == "" {
+		for _, name := range names {
+			if normalize(City(name)) == query {
+				add(name, name)
+			}
+		}
+	}
+
+	switch len(zones) {
+	case 0:
+		return nil, fmt.Errorf("%w %s", ErrUnknownZone, spec)
+	case 1:
+		return LoadLocation(zones[0])
+	default:
+		return nil, fmt.Errorf("%w %s, could be %s", ErrAmbiguous, spec, strings.Join(choices, ", "))
+	}
+}
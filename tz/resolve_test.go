@@ -0,0 +1,143 @@
+package tz
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestResolve(t *testing.T) {
+	winter := time.Date(2025, time.January, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2025, time.July, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		spec   string
+		name   string
+		winter string // winter and summer are the abbreviation and offset in January and July.
+		summer string
+		err    error
+	}{
+		{spec: "America/New_York", name: "America/New_York", winter: "EST -05:00", summer: "EDT -04:00"},
+		{spec: "america/new_york", name: "America/New_York", winter: "EST -05:00", summer: "EDT -04:00"},
+		{spec: "UTC", name: "UTC", winter: "UTC +00:00", summer: "UTC +00:00"},
+		{spec: "Z", name: "UTC", winter: "UTC +00:00", summer: "UTC +00:00"},
+		{spec: "+05:30", name: "UTC+05:30", winter: "UTC+05:30 +05:30", summer: "UTC+05:30 +05:30"},
+		{spec: "-0800", name: "UTC-08:00", winter: "UTC-08:00 -08:00", summer: "UTC-08:00 -08:00"},
+		{spec: "UTC-8", name: "UTC-08:00", winter: "UTC-08:00 -08:00", summer: "UTC-08:00 -08:00"},
+		{spec: "gmt+5:45", name: "UTC+05:45", winter: "UTC+05:45 +05:45", summer: "UTC+05:45 +05:45"},
+		{spec: "+00:00", name: "UTC", winter: "UTC +00:00", summer: "UTC +00:00"},
+		{spec: "PST", name: "PST", winter: "PST -08:00", summer: "PST -08:00"},
+		{spec: "cest", name: "CEST", winter: "CEST +02:00", summer: "CEST +02:00"},
+		{spec: "PT", name: "America/Los_Angeles", winter: "PST -08:00", summer: "PDT -07:00"},
+		{spec: "IST", err: ErrAmbiguous},
+		{spec: "CST", err: ErrAmbiguous},
+		{spec: "tokyo", name: "Asia/Tokyo", winter: "JST +09:00", summer: "JST +09:00"},
+		{spec: "New York", name: "America/New_York", winter: "EST -05:00", summer: "EDT -04:00"},
+		{spec: "san francisco", name: "America/Los_Angeles", winter: "PST -08:00", summer: "PDT -07:00"},
+		{spec: "San Jose", err: ErrAmbiguous},
+		{spec: "San Jose, CR", name: "America/Costa_Rica", winter: "CST -06:00", summer: "CST -06:00"},
+		{
+			spec:   "EST5EDT,M3.2.0,M11.1.0",
+			name:   "EST5EDT,M3.2.0,M11.1.0",
+			winter: "EST -05:00",
+			summer: "EDT -04:00",
+		},
+		{
+			spec:   "AEST-10AEDT,M10.1.0,M4.1.0/3",
+			name:   "AEST-10AEDT,M10.1.0,M4.1.0/3",
+			winter: "AEDT +11:00",
+			summer: "AEST +10:00",
+		},
+		{spec: "<+0530>-5:30", name: "<+0530>-5:30", winter: "+0530 +05:30", summer: "+0530 +05:30"},
+		{spec: "EST5EDT,M3.2", err: ErrInvalidPOSIX},
+		{spec: "+25:00", err: ErrUnknownZone},
+		{spec: "Mars/Olympus_Mons", err: ErrUnknownZone},
+		{spec: "", err: ErrUnknownZone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			loc, err := Resolve(tt.spec)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Resolve() error = %v, expected %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+
+			if loc.String() != tt.name {
+				t.Errorf("Resolve() = %s, expected %s", loc, tt.name)
+			}
+			if actual := winter.In(loc).Format("MST -07:00"); actual != tt.winter {
+				t.Errorf("in winter = %s, expected %s", actual, tt.winter)
+			}
+			if actual := summer.In(loc).Format("MST -07:00"); actual != tt.summer {
+				t.Errorf("in summer = %s, expected %s", actual, tt.summer)
+			}
+		})
+	}
+}
+
+func TestResolveEmbedded(t *testing.T) {
+	// Cities named after their zone, like Tokyo, are found from the zone names, which the embedded database
+	// records in tzdata.go.
+	Use(&embeddedDatabase)
+	t.Cleanup(func() { Use(nil) })
+
+	for spec, expected := range map[string]string{"tokyo": "Asia/Tokyo", "london": "Europe/London"} {
+		loc, err := Resolve(spec)
+		if err != nil {
+			t.Errorf("Resolve(%q) error = %v", spec, err)
+			continue
+		}
+		if loc.String() != expected {
+			t.Errorf("Resolve(%q) = %s, expected %s", spec, loc, expected)
+		}
+	}
+}
+
+func TestResolvePOSIXTransitions(t *testing.T) {
+	loc, err := Resolve("EST5EDT,M3.2.0,M11.1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []time.Time{
+		time.Date(2025, time.March, 9, 7, 0, 0, 0, time.UTC),
+		time.Date(2025, time.November, 2, 6, 0, 0, 0, time.UTC),
+	}
+	actual := InYear(loc, 2025)
+	if len(actual) != len(expected) {
+		t.Fatalf("InYear() = %v, expected transitions at %v", actual, expected)
+	}
+	for i := range actual {
+		if !actual[i].At.Equal(expected[i]) {
+			t.Errorf("InYear()[%d] at %s, expected %s", i, actual[i].At, expected[i])
+		}
+	}
+}
+
+func TestAbbreviations(t *testing.T) {
+	for abbr, meanings := range abbreviations {
+		for _, meaning := range meanings {
+			loc, err := time.LoadLocation(meaning.zone)
+			if err != nil {
+				t.Errorf("%s is used in an unknown zone: %v", abbr, err)
+				continue
+			}
+			if meaning.generic {
+				continue
+			}
+
+			// The zone should use the abbreviation at that offset at some point in the year.
+			found := false
+			for month := time.January; month <= time.December; month++ {
+				name, offset := time.Date(2025, month, 1, 0, 0, 0, 0, loc).Zone()
+				found = found || offset == meaning.offset && (name == abbr || name[0] == '+' || name[0] == '-')
+			}
+			if !found {
+				t.Errorf("%s isn't used by %s at %s", abbr, meaning.zone, FormatOffset(meaning.offset))
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package tz
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// MatchKind is what part of a zone matched a search.
+type MatchKind string
+
+const (
+	MatchName         MatchKind = "name"
+	MatchCity         MatchKind = "city"
+	MatchAbbreviation MatchKind = "abbreviation"
+)
+
+// Match is a zone found by Search.
+type Match struct {
+	Name    string
+	Kind    MatchKind
+	Matched string // Matched is the text that matched, like the city "San Francisco" or "PDT".
+
+	score int
+}
+
+// Search finds the zones whose name or city fuzzily matches query, or that use query as an abbreviation
+// around t, like "PST" or "CEST". The best matches come first.
+func Search(names []string, query string, t time.Time) []Match {
+	q := normalize(query)
+	if q == "" {
+		return nil
+	}
+
+	citiesByZone := make(map[string][]string)
+	for _, c := range cities() {
+		citiesByZone[c.Zone] = append(citiesByZone[c.Zone], c.Name)
+	}
+
+	seasons := [2]time.Time{
+		time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(t.Year(), time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	var matches []Match
+	for _, name := range names {
+		best := Match{Name: name}
+		consider := func(kind MatchKind, text string, score int) {
+			if score > best.score {
+				best.Kind, best.Matched, best.score = kind, text, score
+			}
+		}
+
+		consider(MatchName, name, fuzzy(normalize(name), q))
+		consider(MatchCity, City(name), fuzzy(normalize(City(name)), q))
+		for _, city := range citiesByZone[name] {
+			consider(MatchCity, city, fuzzy(normalize(city), q))
+		}
+
+		// Abbreviations are too short to match fuzzily, and a zone can use two of them in a year, so
+		// both winter and summer are checked.
+		if len(q) >= 2 {
+			if loc, err := LoadLocation(name); err == nil {
+				for _, at := range []time.Time{t, seasons[0], seasons[1]} {
+					abbreviation, _ := at.In(loc).Zone()
+					if strings.EqualFold(abbreviation, query) {
+						consider(MatchAbbreviation, abbreviation, exactScore)
+					}
+				}
+			}
+		}
+
+		if best.score > 0 {
+			matches = append(matches, best)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].Name < matches[j].Name
+	})
+	return matches
+}
+
+const (
+	exactScore      = 100
+	prefixScore     = 90
+	wordScore       = 80
+	substringScore  = 70
+	subsequenceBase = 50
+)
+
+// fuzzy scores how well text matches query, or returns 0 when it doesn't. Exact matches score highest,
+// then prefixes, the start of a word, anywhere in the text, and finally the letters of query in order
+// with gaps between them, which score lower the more is skipped.
+func fuzzy(text, query string) int {
+	switch {
+	case text == query:
+		return exactScore
+	case strings.HasPrefix(text, query):
+		return prefixScore
+	case strings.Contains(text, " "+query) || strings.Contains(text, "/"+query):
+		return wordScore
+	case strings.Contains(text, query):
+		return substringScore
+	}
+
+	letters := []rune(query)
+	skipped, i := 0, 0
+	for _, r := range text {
+		if i < len(letters) && r == letters[i] {
+			i++
+		} else if i > 0 && i < len(letters) {
+			skipped++
+		}
+	}
+	if i < len(letters) || len(letters) < 3 {
+		return 0
+	}
+	return max(subsequenceBase-skipped, 1)
+}
+
+// normalize lowercases text and uses spaces between words, so "new_york", "New York" and "NEW-YORK" are
+// the same.
+func normalize(text string) string {
+	text = strings.ToLower(strings.TrimSpace(text))
+	return strings.NewReplacer("_", " ", "-", " ").Replace(text)
+}
@@ -0,0 +1,71 @@
+package tz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSearch(t *testing.T) {
+	names := []string{"America/Los_Angeles", "America/New_York", "Asia/Kolkata", "Europe/London", "Europe/Berlin", "UTC"}
+	// In October, both Los Angeles and New York are on daylight saving time.
+	at := time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		query    string
+		expected []Match
+	}{
+		{
+			query:    "new york",
+			expected: []Match{{Name: "America/New_York", Kind: MatchCity, Matched: "New York"}},
+		},
+		{
+			query:    "Europe/Lon",
+			expected: []Match{{Name: "Europe/London", Kind: MatchName, Matched: "Europe/London"}},
+		},
+		{
+			query:    "frisco",
+			expected: []Match{{Name: "America/Los_Angeles", Kind: MatchCity, Matched: "San Francisco"}},
+		},
+		{
+			query:    "mumbai",
+			expected: []Match{{Name: "Asia/Kolkata", Kind: MatchCity, Matched: "Mumbai"}},
+		},
+		{
+			query:    "pst",
+			expected: []Match{{Name: "America/Los_Angeles", Kind: MatchAbbreviation, Matched: "PST"}},
+		},
+		{
+			query: "europe",
+			expected: []Match{
+				{Name: "Europe/Berlin", Kind: MatchName, Matched: "Europe/Berlin"},
+				{Name: "Europe/London", Kind: MatchName, Matched: "Europe/London"},
+			},
+		},
+		{
+			query: "atlantis",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			actual := Search(names, tt.query, at)
+			if len(actual) != len(tt.expected) {
+				t.Fatalf("Search() = %v, expected %v", actual, tt.expected)
+			}
+			for i := range actual {
+				actual[i].score = 0
+				if actual[i] != tt.expected[i] {
+					t.Errorf("Search()[%d] = %+v, expected %+v", i, actual[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCities(t *testing.T) {
+	for _, c := range cities() {
+		if _, err := time.LoadLocation(c.Zone); err != nil {
+			t.Errorf("city %s is in an unknown zone: %v", c.Name, err)
+		}
+	}
+}
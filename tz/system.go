@@ -0,0 +1,89 @@
+package tz
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// System is the zone the time package uses for local time, and where it came from.
+type System struct {
+	// Name is the IANA name of the zone when it can be found, like "America/New_York", or "Local".
+	Name     string
+	Location *time.Location
+
+	// Source is where the zone was resolved from: "TZ" for the environment variable, the path of
+	// /etc/localtime, the Windows registry, or "default" when nothing is set and UTC is used. A TZ the time
+	// package can't load, like the POSIX string "EST5EDT,M3.2.0,M11.1.0", is "TZ (invalid, using UTC)".
+	Source string
+}
+
+// DetectSystem resolves the system zone the way the time package does, reading the TZ environment
+// variable first and then /etc/localtime. Like time.Local, a TZ that can't be loaded is UTC. Unlike
+// time.Local, it's resolved again on every call.
+func DetectSystem() (System, error) {
+	if runtime.GOOS == "windows" {
+		return System{Name: "Local", Location: time.Local, Source: "Windows registry"}, nil
+	}
+
+	if tz, ok := os.LookupEnv("TZ"); ok {
+		// An empty TZ is UTC, and a leading colon is allowed by POSIX.
+		if tz == "" {
+			return System{Name: "UTC", Location: time.UTC, Source: "TZ"}, nil
+		}
+		name := strings.TrimPrefix(tz, ":")
+
+		var loc *time.Location
+		var err error
+		if filepath.IsAbs(name) {
+			loc, err = loadFile(name)
+		} else {
+			loc, err = LoadLocation(name)
+		}
+		if err != nil {
+			return System{Name: "UTC", Location: time.UTC, Source: "TZ (invalid, using UTC)"}, nil
+		}
+		return System{Name: loc.String(), Location: loc, Source: "TZ"}, nil
+	}
+
+	const localtime = "/etc/localtime"
+	if _, err := os.Stat(localtime); err != nil {
+		return System{Name: "UTC", Location: time.UTC, Source: "default"}, nil
+	}
+	loc, err := loadFile(localtime)
+	if err != nil {
+		return System{}, err
+	}
+	return System{Name: loc.String(), Location: loc, Source: localtime}, nil
+}
+
+// loadFile loads a zone file, naming it after its path in the database when it's a link into one, like
+// /etc/localtime usually is.
+func loadFile(path string) (*time.Location, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	name := "Local"
+	if target, err := filepath.EvalSymlinks(path); err == nil {
+		if _, zone, found := strings.Cut(filepath.ToSlash(target), "zoneinfo/"); found {
+			name = zone
+		}
+	}
+	// Debian also writes the name of the zone to /etc/timezone.
+	if name == "Local" && path == "/etc/localtime" {
+		if b, err := os.ReadFile("/etc/timezone"); err == nil && strings.TrimSpace(string(b)) != "" {
+			name = strings.TrimSpace(string(b))
+		}
+	}
+
+	loc, err := time.LoadLocationFromTZData(name, data)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", path, err)
+	}
+	return loc, nil
+}
@@ -0,0 +1,64 @@
+package tz
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestDetectSystem(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the system zone comes from the registry on Windows")
+	}
+
+	db, err := FindDatabase()
+	if err != nil || filepath.Ext(db.Path) == ".zip" {
+		t.Skip("needs a zoneinfo directory")
+	}
+
+	tests := []struct {
+		name     string
+		tz       string
+		expected string
+		source   string
+	}{
+		{name: "empty is UTC", tz: "", expected: "UTC"},
+		{name: "zone", tz: "America/New_York", expected: "America/New_York"},
+		{name: "leading colon", tz: ":Europe/London", expected: "Europe/London"},
+		{name: "path", tz: filepath.Join(db.Path, "Asia", "Tokyo"), expected: "Asia/Tokyo"},
+		{name: "unknown", tz: "Mars/Olympus_Mons", expected: "UTC", source: "TZ (invalid, using UTC)"},
+		{name: "posix", tz: "EST5EDT,M3.2.0,M11.1.0", expected: "UTC", source: "TZ (invalid, using UTC)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TZ", tt.tz)
+
+			system, err := DetectSystem()
+			if err != nil {
+				t.Fatal(err)
+			}
+			source := tt.source
+			if source == "" {
+				source = "TZ"
+			}
+			if system.Name != tt.expected || system.Source != source {
+				t.Errorf("DetectSystem() = %s from %s, expected %s from %s", system.Name, system.Source, tt.expected, source)
+			}
+		})
+	}
+
+	t.Run("unset", func(t *testing.T) {
+		t.Setenv("TZ", "")
+		os.Unsetenv("TZ")
+
+		system, err := DetectSystem()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if system.Source != "/etc/localtime" && system.Source != "default" {
+			t.Errorf("DetectSystem() source = %s, expected /etc/localtime or default", system.Source)
+		}
+	})
+}
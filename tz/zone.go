@@ -0,0 +1,211 @@
+package tz
+
+import (
+	"fmt"
+	"time"
+)
+
+// Zone is the offset and abbreviation a location uses at some instant.
+type Zone struct {
+	Name         string
+	Abbreviation string
+	Offset       int // Offset is in seconds east of UTC.
+	DST          bool
+}
+
+// At returns the zone loc uses at t.
+func At(loc *time.Location, t time.Time) Zone {
+	t = t.In(loc)
+	abbreviation, offset := t.Zone()
+	return Zone{
+		Name:         loc.String(),
+		Abbreviation: abbreviation,
+		Offset:       offset,
+		DST:          t.IsDST(),
+	}
+}
+
+// FormatOffset writes an offset in seconds like "+05:30". Offsets with seconds, which some zones used
+// before standard time, are written like "-00:01:15".
+func FormatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	if offset%60 != 0 {
+		return fmt.Sprintf("%s%02d:%02d:%02d", sign, offset/3600, offset/60%60, offset%60)
+	}
+	return fmt.Sprintf("%s%02d:%02d", sign, offset/3600, offset/60%60)
+}
+
+// Transition is a change in the offset or abbreviation of a location, like the start of daylight saving
+// time.
+type Transition struct {
+	At     time.Time // At is the first instant of the new zone, in UTC.
+	Before Zone
+	After  Zone
+}
+
+// Next returns the first transition of loc after t. It is false when loc doesn't change again, like UTC
+// or a zone that has stopped observing daylight saving time.
+func Next(loc *time.Location, t time.Time) (Transition, bool) {
+	// Zones from POSIX TZ strings end at every new year, even when nothing changes, so those are skipped.
+	for range maxSkipped {
+		_, end := t.In(loc).ZoneBounds()
+		if end.IsZero() {
+			return Transition{}, false
+		}
+		transition := Transition{
+			At:     end.UTC(),
+			Before: At(loc, end.Add(-time.Nanosecond)),
+			After:  At(loc, end),
+		}
+		if transition.Before != transition.After {
+			return transition, true
+		}
+		t = end
+	}
+	return Transition{}, false
+}
+
+// maxSkipped is how many bounds of a zone that don't change anything are skipped looking for a transition.
+const maxSkipped = 500
+
+// Upcoming returns up to n transitions of loc after t.
+func Upcoming(loc *time.Location, t time.Time, n int) []Transition {
+	var transitions []Transition
+	for len(transitions) < n {
+		next, ok := Next(loc, t)
+		if !ok {
+			break
+		}
+		transitions = append(transitions, next)
+		t = next.At
+	}
+	return transitions
+}
+
+// Previous returns the last transition of loc at or before t. It is false when loc has never changed.
+func Previous(loc *time.Location, t time.Time) (Transition, bool) {
+	for range maxSkipped {
+		start, _ := t.In(loc).ZoneBounds()
+		if start.IsZero() {
+			return Transition{}, false
+		}
+		transition := Transition{
+			At:     start.UTC(),
+			Before: At(loc, start.Add(-time.Nanosecond)),
+			After:  At(loc, start),
+		}
+		if transition.Before != transition.After {
+			return transition, true
+		}
+		t = start.Add(-time.Nanosecond)
+	}
+	return Transition{}, false
+}
+
+// Nearest returns the transition of loc closest to t, before or after it, when it's within window.
+func Nearest(loc *time.Location, t time.Time, window time.Duration) (Transition, bool) {
+	var candidates []Transition
+	if previous, ok := Previous(loc, t); ok {
+		candidates = append(candidates, previous)
+	}
+	if next, ok := Next(loc, t); ok {
+		candidates = append(candidates, next)
+	}
+
+	var nearest Transition
+	found := false
+	for _, candidate := range candidates {
+		distance := candidate.At.Sub(t).Abs()
+		if distance <= window && (!found || distance < nearest.At.Sub(t).Abs()) {
+			nearest, found = candidate, true
+		}
+	}
+	return nearest, found
+}
+
+// InYear returns the transitions of loc in a year of its local calendar.
+func InYear(loc *time.Location, year int) []Transition {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+
+	var transitions []Transition
+	// Start just before midnight, in case a transition happens at the start of the year.
+	t := start.Add(-time.Nanosecond)
+	for {
+		next, ok := Next(loc, t)
+		if !ok || !next.At.Before(end) {
+			break
+		}
+		transitions = append(transitions, next)
+		t = next.At
+	}
+	return transitions
+}
+
+// Shift is how far the clocks move at the transition, positive when they move forward.
+func (t Transition) Shift() time.Duration {
+	return time.Duration(t.After.Offset-t.Before.Offset) * time.Second
+}
+
+// Ambiguous reports whether the wall-clock time of t in loc happens twice, because the clocks moved back
+// past it, and returns the transition that repeats it.
+func Ambiguous(loc *time.Location, t time.Time) (Transition, bool) {
+	// The first time through, the clocks are about to move back past the wall time.
+	if next, ok := Next(loc, t); ok && next.Shift() < 0 && next.At.Sub(t) <= -next.Shift() {
+		return next, true
+	}
+	// The second time through, the clocks have just moved back.
+	if previous, ok := Previous(loc, t); ok && previous.Shift() < 0 && t.Sub(previous.At) < -previous.Shift() {
+		return previous, true
+	}
+	return Transition{}, false
+}
+
+// Reading is an instant a wall-clock time has in a location.
+type Reading struct {
+	Time time.Time // Time is in the location.
+
+	// Zone is the zone the wall-clock time was read in. For a wall-clock time that's skipped, it's the zone
+	// on one side of the gap, which isn't in effect at Time.
+	Zone Zone
+}
+
+// Wall returns the readings of the date and wall-clock time of wall in loc, ignoring the location of wall.
+// There's usually one, but a wall-clock time the clocks moved back past happens twice, and one they moved
+// forward past doesn't happen at all, so it's read in the zones on either side of the gap. The readings are
+// in order, and the transition is returned when there's more than one.
+func Wall(loc *time.Location, wall time.Time) ([]Reading, Transition, bool) {
+	y, m, d := wall.Date()
+	utc := time.Date(y, m, d, wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), time.UTC)
+
+	// Offsets are less than a day, so the zones a day either side of the wall-clock time read as UTC are the
+	// ones that could apply.
+	before, after := At(loc, utc.Add(-24*time.Hour)), At(loc, utc.Add(24*time.Hour))
+	read := func(zone Zone) (Reading, bool) {
+		t := utc.Add(-time.Duration(zone.Offset) * time.Second).In(loc)
+		return Reading{Time: t, Zone: zone}, At(loc, t).Offset == zone.Offset
+	}
+
+	first, firstValid := read(before)
+	if before.Offset == after.Offset {
+		first.Zone = At(loc, first.Time)
+		return []Reading{first}, Transition{}, false
+	}
+	second, secondValid := read(after)
+
+	switch {
+	case firstValid && !secondValid:
+		return []Reading{first}, Transition{}, false
+	case secondValid && !firstValid:
+		return []Reading{second}, Transition{}, false
+	}
+
+	transition, _ := Next(loc, utc.Add(-24*time.Hour))
+	if second.Time.Before(first.Time) {
+		first, second = second, first
+	}
+	return []Reading{first, second}, transition, true
+}
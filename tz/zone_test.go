@@ -0,0 +1,234 @@
+package tz
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatOffset(t *testing.T) {
+	tests := []struct {
+		offset   int
+		expected string
+	}{
+		{offset: 0, expected: "+00:00"},
+		{offset: 5*3600 + 30*60, expected: "+05:30"},
+		{offset: -4 * 3600, expected: "-04:00"},
+		{offset: -75, expected: "-00:01:15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if actual := FormatOffset(tt.offset); actual != tt.expected {
+				t.Errorf("FormatOffset() = %q, expected %q", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUpcoming(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		loc      *time.Location
+		n        int
+		expected []Transition
+	}{
+		{
+			name: "daylight saving time",
+			loc:  newYork,
+			n:    2,
+			expected: []Transition{
+				{
+					At:     time.Date(2025, time.March, 9, 7, 0, 0, 0, time.UTC),
+					Before: Zone{Name: "America/New_York", Abbreviation: "EST", Offset: -5 * 3600},
+					After:  Zone{Name: "America/New_York", Abbreviation: "EDT", Offset: -4 * 3600, DST: true},
+				},
+				{
+					At:     time.Date(2025, time.November, 2, 6, 0, 0, 0, time.UTC),
+					Before: Zone{Name: "America/New_York", Abbreviation: "EDT", Offset: -4 * 3600, DST: true},
+					After:  Zone{Name: "America/New_York", Abbreviation: "EST", Offset: -5 * 3600},
+				},
+			},
+		},
+		{
+			name: "no transitions",
+			loc:  time.UTC,
+			n:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := Upcoming(tt.loc, from, tt.n)
+			if len(actual) != len(tt.expected) {
+				t.Fatalf("Upcoming() = %v, expected %v", actual, tt.expected)
+			}
+			for i := range actual {
+				if !actual[i].At.Equal(tt.expected[i].At) || actual[i].Before != tt.expected[i].Before || actual[i].After != tt.expected[i].After {
+					t.Errorf("Upcoming()[%d] = %+v, expected %+v", i, actual[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestInYear(t *testing.T) {
+	tests := []struct {
+		zone     string
+		year     int
+		expected []time.Time
+	}{
+		{
+			zone: "America/New_York",
+			year: 2000,
+			expected: []time.Time{
+				time.Date(2000, time.April, 2, 7, 0, 0, 0, time.UTC),
+				time.Date(2000, time.October, 29, 6, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			zone: "Europe/Moscow",
+			year: 2014,
+			expected: []time.Time{
+				time.Date(2014, time.October, 25, 22, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			zone: "Asia/Tokyo",
+			year: 2020,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual := InYear(loc, tt.year)
+			if len(actual) != len(tt.expected) {
+				t.Fatalf("InYear() = %v, expected transitions at %v", actual, tt.expected)
+			}
+			for i := range actual {
+				if !actual[i].At.Equal(tt.expected[i]) {
+					t.Errorf("InYear()[%d] at %s, expected %s", i, actual[i].At, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNearestAndAmbiguous(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	springForward := time.Date(2025, time.March, 9, 7, 0, 0, 0, time.UTC)
+	fallBack := time.Date(2025, time.November, 2, 6, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		t         time.Time
+		nearest   time.Time // nearest is the zero time when there's no transition within 12 hours.
+		ambiguous bool
+	}{
+		{name: "far from a transition", t: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "before spring forward", t: springForward.Add(-2 * time.Hour), nearest: springForward},
+		{name: "after spring forward", t: springForward.Add(3 * time.Hour), nearest: springForward},
+		{name: "before the repeated hour", t: fallBack.Add(-2*time.Hour - time.Second), nearest: fallBack},
+		{name: "first time through", t: fallBack.Add(-time.Hour), nearest: fallBack, ambiguous: true},
+		{name: "second time through", t: fallBack.Add(59 * time.Minute), nearest: fallBack, ambiguous: true},
+		{name: "after the repeated hour", t: fallBack.Add(time.Hour), nearest: fallBack},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nearest, ok := Nearest(newYork, tt.t, 12*time.Hour)
+			if ok != !tt.nearest.IsZero() || ok && !nearest.At.Equal(tt.nearest) {
+				t.Errorf("Nearest() = %s, %t, expected %s", nearest.At, ok, tt.nearest)
+			}
+			if _, ambiguous := Ambiguous(newYork, tt.t); ambiguous != tt.ambiguous {
+				t.Errorf("Ambiguous() = %t, expected %t", ambiguous, tt.ambiguous)
+			}
+		})
+	}
+}
+
+func TestWall(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		loc      *time.Location
+		wall     time.Time
+		readings []string // readings are the instants in UTC and the zones they were read in.
+	}{
+		{
+			name:     "summer",
+			loc:      newYork,
+			wall:     time.Date(2025, time.June, 28, 9, 0, 0, 0, time.UTC),
+			readings: []string{"2025-06-28T13:00:00Z EDT"},
+		},
+		{
+			name:     "no daylight saving time",
+			loc:      kolkata,
+			wall:     time.Date(2025, time.March, 9, 2, 30, 0, 0, time.UTC),
+			readings: []string{"2025-03-08T21:00:00Z IST"},
+		},
+		{
+			name:     "just before spring forward",
+			loc:      newYork,
+			wall:     time.Date(2025, time.March, 9, 1, 59, 0, 0, time.UTC),
+			readings: []string{"2025-03-09T06:59:00Z EST"},
+		},
+		{
+			name:     "skipped by spring forward",
+			loc:      newYork,
+			wall:     time.Date(2025, time.March, 9, 2, 30, 0, 0, time.UTC),
+			readings: []string{"2025-03-09T06:30:00Z EDT", "2025-03-09T07:30:00Z EST"},
+		},
+		{
+			name:     "repeated by fall back",
+			loc:      newYork,
+			wall:     time.Date(2025, time.November, 2, 1, 30, 0, 0, time.UTC),
+			readings: []string{"2025-11-02T05:30:00Z EDT", "2025-11-02T06:30:00Z EST"},
+		},
+		{
+			name:     "the location of wall is ignored",
+			loc:      newYork,
+			wall:     time.Date(2025, time.November, 2, 1, 30, 0, 0, kolkata),
+			readings: []string{"2025-11-02T05:30:00Z EDT", "2025-11-02T06:30:00Z EST"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readings, transition, ok := Wall(tt.loc, tt.wall)
+
+			var actual []string
+			for _, reading := range readings {
+				actual = append(actual, reading.Time.UTC().Format(time.RFC3339)+" "+reading.Zone.Abbreviation)
+			}
+			if strings.Join(actual, ", ") != strings.Join(tt.readings, ", ") {
+				t.Errorf("Wall() = %v, expected %v", actual, tt.readings)
+			}
+			if ok != (len(tt.readings) > 1) || ok && transition.At.IsZero() {
+				t.Errorf("Wall() transition = %v, %t", transition, ok)
+			}
+		})
+	}
+}
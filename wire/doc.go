@@ -0,0 +1,7 @@
+// Package wire is a module for decoding the timestamps found in binary serialization formats, like
+// a google.protobuf.Timestamp message, a BSON datetime, the MessagePack timestamp extension or a CBOR
+// epoch-based date-time.
+//
+// Captures are usually copied as hex or base64, so DecodeString accepts both and detects which one
+// it was given.
+package wire
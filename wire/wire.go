@@ -0,0 +1,351 @@
+package wire
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DanStough/epok/parse"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown format")
+	ErrInvalidInput  = errors.New("input is neither hex nor base64")
+	ErrMalformed     = errors.New("malformed timestamp")
+	ErrOutOfRange    = errors.New("timestamp outside the years 0001 to 9999")
+)
+
+// Format is a binary encoding of a timestamp.
+type Format string
+
+const (
+	// ProtobufTimestamp is a serialized google.protobuf.Timestamp message: seconds in field 1 and
+	// nanoseconds in field 2, both as varints.
+	ProtobufTimestamp Format = "protobuf-timestamp"
+	// BSONDatetime is a signed little-endian int64 of milliseconds, either alone or as a whole
+	// element with its 0x09 type and name.
+	BSONDatetime Format = "bson-datetime"
+	// MsgpackExt is the MessagePack timestamp extension, type -1, in any of its 32, 64 or 96-bit forms.
+	MsgpackExt Format = "msgpack-ext"
+	// CBORTag1 is a CBOR epoch-based date-time: tag 1 followed by an integer or a float of seconds.
+	CBORTag1 Format = "cbor-tag1"
+	// BE32 is an unsigned big-endian uint32 of seconds, which runs from 1970 to 2106.
+	BE32 Format = "be32"
+	// BE64 is a signed big-endian int64. Its precision is inferred like a Unix timestamp by parse.Int.
+	BE64 Format = "be64"
+)
+
+// Formats are the supported binary encodings.
+var Formats = []Format{ProtobufTimestamp, BSONDatetime, MsgpackExt, CBORTag1, BE32, BE64}
+
+// ParseFormat reads the name of a binary encoding. "protobuf", "bson", "msgpack" and "cbor" are
+// accepted as shorthands.
+func ParseFormat(s string) (Format, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch s {
+	case "protobuf", "proto":
+		return ProtobufTimestamp, nil
+	case "bson":
+		return BSONDatetime, nil
+	case "msgpack":
+		return MsgpackExt, nil
+	case "cbor":
+		return CBORTag1, nil
+	}
+	for _, f := range Formats {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", ErrUnknownFormat
+}
+
+// DecodeString reads a timestamp given as hex, like "08a68efdc206" or "0x08 a6 8e fd c2 06", or as base64,
+// like "CKaO/cIG". Input that is valid hex is never read as base64.
+//
+// A protobuf timestamp can also be given in its JSON object form, {"seconds": ..., "nanos": ...}.
+func DecodeString(s string, f Format) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if f == ProtobufTimestamp && strings.HasPrefix(s, "{") {
+		return checkRange(decodeProtobufJSON(s))
+	}
+
+	data, err := Bytes(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return Decode(data, f)
+}
+
+// Bytes reads hex or base64 input. Hex can have a 0x prefix, and its bytes can be separated by
+// spaces or colons.
+func Bytes(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+
+	stripped := s
+	if len(stripped) > 2 && (stripped[:2] == "0x" || stripped[:2] == "0X") {
+		stripped = stripped[2:]
+	}
+	stripped = strings.NewReplacer(" ", "", ":", "", "\n", "", "\t", "").Replace(stripped)
+	if data, err := hex.DecodeString(stripped); err == nil && len(data) > 0 {
+		return data, nil
+	}
+
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if data, err := enc.DecodeString(s); err == nil && len(data) > 0 {
+			return data, nil
+		}
+	}
+	return nil, ErrInvalidInput
+}
+
+// Decode reads a timestamp in the binary encoding. Every byte must belong to the timestamp.
+//
+// Timestamps outside the years 0001 to 9999, which google.protobuf.Timestamp is limited to and RFC 3339
+// can't write, are ErrOutOfRange in every format.
+func Decode(data []byte, f Format) (time.Time, error) {
+	return checkRange(decode(data, f))
+}
+
+// checkRange rejects decoded timestamps outside the years 0001 to 9999.
+func checkRange(t time.Time, err error) (time.Time, error) {
+	if err != nil {
+		return time.Time{}, err
+	}
+	if year := t.UTC().Year(); year < 1 || year > 9999 {
+		return time.Time{}, fmt.Errorf("%w: year %d", ErrOutOfRange, year)
+	}
+	return t, nil
+}
+
+func decode(data []byte, f Format) (time.Time, error) {
+	switch f {
+	case ProtobufTimestamp:
+		return decodeProtobuf(data)
+	case BSONDatetime:
+		return decodeBSON(data)
+	case MsgpackExt:
+		return decodeMsgpack(data)
+	case CBORTag1:
+		return decodeCBOR(data)
+	case BE32:
+		if len(data) != 4 {
+			return time.Time{}, fmt.Errorf("%w: be32 is 4 bytes, got %d", ErrMalformed, len(data))
+		}
+		return time.Unix(int64(binary.BigEndian.Uint32(data)), 0), nil
+	case BE64:
+		if len(data) != 8 {
+			return time.Time{}, fmt.Errorf("%w: be64 is 8 bytes, got %d", ErrMalformed, len(data))
+		}
+		return parse.Int(int64(binary.BigEndian.Uint64(data)))
+	default:
+		return time.Time{}, fmt.Errorf("%w: %s", ErrUnknownFormat, f)
+	}
+}
+
+// timestamp checks the fields of a protobuf or MessagePack timestamp.
+func timestamp(seconds int64, nanos int64) (time.Time, error) {
+	if nanos < 0 || nanos >= int64(time.Second) {
+		return time.Time{}, fmt.Errorf("%w: nanoseconds must be between 0 and 999999999, got %d", ErrMalformed, nanos)
+	}
+	return time.Unix(seconds, nanos), nil
+}
+
+// decodeProtobuf reads the wire format of a google.protobuf.Timestamp. Missing fields are zero, and
+// unknown fields are skipped.
+func decodeProtobuf(data []byte) (time.Time, error) {
+	var seconds, nanos int64
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return time.Time{}, fmt.Errorf("%w: invalid protobuf field key", ErrMalformed)
+		}
+		data = data[n:]
+
+		field, wireType := key>>3, key&0x7
+		switch wireType {
+		case 0: // varint
+			value, n := binary.Uvarint(data)
+			if n <= 0 {
+				return time.Time{}, fmt.Errorf("%w: invalid protobuf varint", ErrMalformed)
+			}
+			data = data[n:]
+			switch field {
+			case 1:
+				seconds = int64(value)
+			case 2:
+				// int32 fields are sign-extended to 64 bits.
+				nanos = int64(int32(value))
+			}
+		case 1: // fixed64
+			if len(data) < 8 {
+				return time.Time{}, fmt.Errorf("%w: truncated protobuf field", ErrMalformed)
+			}
+			data = data[8:]
+		case 2: // length-delimited
+			length, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < length {
+				return time.Time{}, fmt.Errorf("%w: truncated protobuf field", ErrMalformed)
+			}
+			data = data[n+int(length):]
+		case 5: // fixed32
+			if len(data) < 4 {
+				return time.Time{}, fmt.Errorf("%w: truncated protobuf field", ErrMalformed)
+			}
+			data = data[4:]
+		default:
+			return time.Time{}, fmt.Errorf("%w: unsupported protobuf wire type %d", ErrMalformed, wireType)
+		}
+	}
+	return timestamp(seconds, nanos)
+}
+
+// decodeProtobufJSON reads {"seconds": ..., "nanos": ...}. The JSON mapping of int64 is a string,
+// but numbers are accepted too.
+func decodeProtobufJSON(s string) (time.Time, error) {
+	var message struct {
+		Seconds json.Number `json:"seconds"`
+		Nanos   int64       `json:"nanos"`
+	}
+	if err := json.Unmarshal([]byte(s), &message); err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", ErrMalformed, err)
+	}
+
+	var seconds int64
+	if message.Seconds != "" {
+		var err error
+		seconds, err = strconv.ParseInt(message.Seconds.String(), 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: seconds must be an int64, got %s", ErrMalformed, message.Seconds)
+		}
+	}
+	return timestamp(seconds, message.Nanos)
+}
+
+// decodeBSON reads a BSON datetime, or a whole element: the 0x09 type, a null-terminated name and the value.
+func decodeBSON(data []byte) (time.Time, error) {
+	if len(data) > 8 && data[0] == 0x09 {
+		if end := strings.IndexByte(string(data[1:]), 0); end >= 0 {
+			data = data[end+2:]
+		}
+	}
+	if len(data) != 8 {
+		return time.Time{}, fmt.Errorf("%w: a BSON datetime is 8 bytes, got %d", ErrMalformed, len(data))
+	}
+	return time.UnixMilli(int64(binary.LittleEndian.Uint64(data))), nil
+}
+
+// decodeMsgpack reads the timestamp extension: fixext 4 holds uint32 seconds, fixext 8 holds 30 bits of
+// nanoseconds and 34 bits of seconds, and ext 8 with a length of 12 holds uint32 nanoseconds and int64 seconds.
+func decodeMsgpack(data []byte) (time.Time, error) {
+	const timestampType = 0xff // -1
+
+	switch {
+	case len(data) == 6 && data[0] == 0xd6 && data[1] == timestampType:
+		return time.Unix(int64(binary.BigEndian.Uint32(data[2:])), 0), nil
+	case len(data) == 10 && data[0] == 0xd7 && data[1] == timestampType:
+		value := binary.BigEndian.Uint64(data[2:])
+		return timestamp(int64(value&(1<<34-1)), int64(value>>34))
+	case len(data) == 15 && data[0] == 0xc7 && data[1] == 12 && data[2] == timestampType:
+		nanos := binary.BigEndian.Uint32(data[3:])
+		return timestamp(int64(binary.BigEndian.Uint64(data[7:])), int64(nanos))
+	default:
+		return time.Time{}, fmt.Errorf("%w: not a MessagePack timestamp extension", ErrMalformed)
+	}
+}
+
+// decodeCBOR reads tag 1 followed by an integer or a half, single or double-precision float of seconds.
+func decodeCBOR(data []byte) (time.Time, error) {
+	if len(data) < 2 || data[0] != 0xc1 {
+		return time.Time{}, fmt.Errorf("%w: not a CBOR tag 1", ErrMalformed)
+	}
+	data = data[1:]
+
+	major, info := data[0]>>5, data[0]&0x1f
+	argument, size := cborArgument(info)
+	if size < 0 || len(data) != 1+size {
+		return time.Time{}, fmt.Errorf("%w: CBOR item has the wrong length", ErrMalformed)
+	}
+	if size > 0 {
+		argument = 0
+		for _, b := range data[1:] {
+			argument = argument<<8 | uint64(b)
+		}
+	}
+
+	switch major {
+	case 0: // unsigned integer
+		if argument > math.MaxInt64 {
+			return time.Time{}, fmt.Errorf("%w: CBOR integer out of range", ErrMalformed)
+		}
+		return time.Unix(int64(argument), 0), nil
+	case 1: // negative integer, -1 - argument
+		if argument > math.MaxInt64 {
+			return time.Time{}, fmt.Errorf("%w: CBOR integer out of range", ErrMalformed)
+		}
+		return time.Unix(-1-int64(argument), 0), nil
+	case 7: // float
+		var seconds float64
+		switch size {
+		case 2:
+			seconds = halfFloat(uint16(argument))
+		case 4:
+			seconds = float64(math.Float32frombits(uint32(argument)))
+		case 8:
+			seconds = math.Float64frombits(argument)
+		default:
+			return time.Time{}, fmt.Errorf("%w: CBOR simple values aren't timestamps", ErrMalformed)
+		}
+		if math.IsNaN(seconds) || math.IsInf(seconds, 0) || math.Abs(seconds) >= 1<<63 {
+			return time.Time{}, fmt.Errorf("%w: CBOR float out of range", ErrMalformed)
+		}
+		whole, fraction := math.Modf(seconds)
+		return time.Unix(int64(whole), int64(math.Round(fraction*float64(time.Second)))), nil
+	default:
+		return time.Time{}, fmt.Errorf("%w: CBOR tag 1 must hold a number", ErrMalformed)
+	}
+}
+
+// cborArgument returns the value held in the additional information, or the number of bytes that follow
+// with the value. The size is -1 for the reserved and indefinite-length values.
+func cborArgument(info byte) (uint64, int) {
+	switch {
+	case info < 24:
+		return uint64(info), 0
+	case info <= 27:
+		return 0, 1 << (info - 24)
+	default:
+		return 0, -1
+	}
+}
+
+// halfFloat converts an IEEE 754 half-precision float, following the example decoder in RFC 8949.
+func halfFloat(h uint16) float64 {
+	exponent := int(h>>10) & 0x1f
+	mantissa := float64(h & 0x3ff)
+
+	var value float64
+	switch exponent {
+	case 0:
+		value = math.Ldexp(mantissa, -24)
+	case 0x1f:
+		if mantissa == 0 {
+			value = math.Inf(1)
+		} else {
+			value = math.NaN()
+		}
+	default:
+		value = math.Ldexp(mantissa+1024, exponent-25)
+	}
+	if h&0x8000 != 0 {
+		return -value
+	}
+	return value
+}
@@ -0,0 +1,245 @@
+package wire
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDecodeString(t *testing.T) {
+	instant := time.Date(2025, time.June, 28, 1, 36, 38, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		format   Format
+		expected time.Time
+		err      error
+	}{
+		{
+			name:     "protobuf hex",
+			input:    "08a68efdc20610c0a9d33a",
+			format:   ProtobufTimestamp,
+			expected: instant.Add(123 * time.Millisecond),
+		},
+		{
+			name:     "protobuf base64",
+			input:    "CKaO/cIGEMCp0zo=",
+			format:   ProtobufTimestamp,
+			expected: instant.Add(123 * time.Millisecond),
+		},
+		{
+			name:     "protobuf url-safe base64 without padding",
+			input:    "CKaO_cIGEMCp0zo",
+			format:   ProtobufTimestamp,
+			expected: instant.Add(123 * time.Millisecond),
+		},
+		{
+			name:     "protobuf separated hex with a prefix",
+			input:    "0x08 a6 8e fd c2 06",
+			format:   ProtobufTimestamp,
+			expected: instant,
+		},
+		{
+			name:     "protobuf negative seconds",
+			input:    "08ffffffffffffffffff01",
+			format:   ProtobufTimestamp,
+			expected: time.Unix(-1, 0),
+		},
+		{
+			name:     "protobuf unknown fields are skipped",
+			input:    "08a68efdc2061a0161",
+			format:   ProtobufTimestamp,
+			expected: instant,
+		},
+		{
+			name:     "protobuf json",
+			input:    `{"seconds": "1751074598", "nanos": 123000000}`,
+			format:   ProtobufTimestamp,
+			expected: instant.Add(123 * time.Millisecond),
+		},
+		{
+			name:     "protobuf json with a number",
+			input:    `{"seconds": 1751074598}`,
+			format:   ProtobufTimestamp,
+			expected: instant,
+		},
+		{
+			name:   "protobuf json with invalid nanos",
+			input:  `{"seconds": 1751074598, "nanos": 1000000000}`,
+			format: ProtobufTimestamp,
+			err:    ErrMalformed,
+		},
+		{
+			name:   "protobuf json after 9999",
+			input:  `{"seconds":"9000000000000000000"}`,
+			format: ProtobufTimestamp,
+			err:    ErrOutOfRange,
+		},
+		{
+			name:   "protobuf before 0001",
+			input:  "0880808080808080808001",
+			format: ProtobufTimestamp,
+			err:    ErrOutOfRange,
+		},
+		{
+			name:   "protobuf truncated varint",
+			input:  "08a68e",
+			format: ProtobufTimestamp,
+			err:    ErrMalformed,
+		},
+		{
+			name:     "bson datetime",
+			input:    "ebec2db497010000",
+			format:   BSONDatetime,
+			expected: instant.Add(123 * time.Millisecond),
+		},
+		{
+			name:     "bson element",
+			input:    "0963726561746564417400ebec2db497010000",
+			format:   BSONDatetime,
+			expected: instant.Add(123 * time.Millisecond),
+		},
+		{
+			name:   "bson after 9999",
+			input:  "ffffffffffffff7f",
+			format: BSONDatetime,
+			err:    ErrOutOfRange,
+		},
+		{
+			name:   "bson wrong length",
+			input:  "ebec2db4",
+			format: BSONDatetime,
+			err:    ErrMalformed,
+		},
+		{
+			name:     "msgpack timestamp 32",
+			input:    "d6ff685f4726",
+			format:   MsgpackExt,
+			expected: instant,
+		},
+		{
+			name:     "msgpack timestamp 64",
+			input:    "d7ff1d6f3454685f4726",
+			format:   MsgpackExt,
+			expected: instant.Add(123456789 * time.Nanosecond),
+		},
+		{
+			name:     "msgpack timestamp 96",
+			input:    "c70cff00000005ffffffffffffffff",
+			format:   MsgpackExt,
+			expected: time.Unix(-1, 5),
+		},
+		{
+			name:   "msgpack other extension",
+			input:  "d601685f4726",
+			format: MsgpackExt,
+			err:    ErrMalformed,
+		},
+		{
+			name:     "cbor integer",
+			input:    "c11a685f4726",
+			format:   CBORTag1,
+			expected: instant,
+		},
+		{
+			name:     "cbor negative integer",
+			input:    "c120",
+			format:   CBORTag1,
+			expected: time.Unix(-1, 0),
+		},
+		{
+			name:     "cbor double",
+			input:    "c1fb41da17d1c9a00000",
+			format:   CBORTag1,
+			expected: instant.Add(500 * time.Millisecond),
+		},
+		{
+			name:     "cbor half",
+			input:    "c1f93e00",
+			format:   CBORTag1,
+			expected: time.Unix(1, 500_000_000),
+		},
+		{
+			name:   "cbor other tag",
+			input:  "c01a685f4726",
+			format: CBORTag1,
+			err:    ErrMalformed,
+		},
+		{
+			name:   "cbor string",
+			input:  "c16161",
+			format: CBORTag1,
+			err:    ErrMalformed,
+		},
+		{
+			name:     "be32",
+			input:    "685f4726",
+			format:   BE32,
+			expected: instant,
+		},
+		{
+			name:     "be64 infers the precision",
+			input:    "00000197b42deceb",
+			format:   BE64,
+			expected: instant.Add(123 * time.Millisecond),
+		},
+		{
+			name:   "be64 wrong length",
+			input:  "685f4726",
+			format: BE64,
+			err:    ErrMalformed,
+		},
+		{
+			name:   "neither hex nor base64",
+			input:  "not a blob!",
+			format: BE32,
+			err:    ErrInvalidInput,
+		},
+		{
+			name:   "unknown format",
+			input:  "685f4726",
+			format: "avro",
+			err:    ErrUnknownFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DecodeString(tt.input, tt.format)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Format
+		err      error
+	}{
+		{input: "protobuf-timestamp", expected: ProtobufTimestamp},
+		{input: "protobuf", expected: ProtobufTimestamp},
+		{input: "BSON", expected: BSONDatetime},
+		{input: "cbor-tag1", expected: CBORTag1},
+		{input: "be64", expected: BE64},
+		{input: "avro", err: ErrUnknownFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseFormat(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}